@@ -16,7 +16,7 @@ type Achievement struct {
 	ID               int                 `json:"id"`
 	AchievementIcon  int                 `json:"achievement_icon"`
 	Category         AchievementCategory `json:"category"`
-	CreatedAt        int                 `json:"created_at"`
+	CreatedAt        UnixTime            `json:"created_at"`
 	Description      string              `json:"description"`
 	ExternalID       string              `json:"external_id"`
 	Game             int                 `json:"game"`
@@ -26,7 +26,7 @@ type Achievement struct {
 	Rank             AchievementRank     `json:"rank"`
 	Slug             string              `json:"slug"`
 	Tags             []Tag               `json:"tags"`
-	UpdatedAt        int                 `json:"updated_at"`
+	UpdatedAt        UnixTime            `json:"updated_at"`
 }
 
 // AchievementRank specifies an achievement's rank ranging
@@ -94,6 +94,20 @@ func (as *AchievementService) Get(id int, opts ...Option) (*Achievement, error)
 	return ach[0], nil
 }
 
+// AchievementBySlug returns a single Achievement identified by the provided
+// slug using the provided AchievementReader, such as the Achievements field of
+// a Client. Provide the SetFields functional option if you need to specify
+// which fields to retrieve. If the slug does not match any Achievements, an
+// error is returned.
+func AchievementBySlug(r AchievementReader, slug string, opts ...Option) (*Achievement, error) {
+	ach, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Achievement with slug %s", slug)
+	}
+
+	return ach[0], nil
+}
+
 // List returns a list of Achievements identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Achievement is ignored. If none of the IDs
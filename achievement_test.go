@@ -57,6 +57,49 @@ func TestAchievementService_Get(t *testing.T) {
 	}
 }
 
+func TestAchievementBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testAchievementGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Achievement, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name            string
+		file            string
+		slug            string
+		opts            []Option
+		wantAchievement *Achievement
+		wantErr         error
+	}{
+		{"Valid response", testAchievementGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ach, err := AchievementBySlug(c.Achievements, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ach, test.wantAchievement) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ach, test.wantAchievement)
+			}
+		})
+	}
+}
+
 func TestAchievementService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testAchievementList)
 	if err != nil {
@@ -28,9 +28,15 @@ type AgeRatingCategory int
 const (
 	AgeRatingESRB AgeRatingCategory = iota + 1
 	AgeRatingPEGI
+	AgeRatingCERO
+	AgeRatingUSK
+	AgeRatingGRAC
+	AgeRatingClassInd
+	AgeRatingACB
 )
 
-// AgeRatingEnum specifies a specific age rating.
+// AgeRatingEnum specifies a specific age rating. The CERO and ACB ratings
+// are spelled Cero and Acb so their names split into words (e.g. cero_a).
 type AgeRatingEnum int
 
 // Expected AgeRatingEnum enums from the IGDB.
@@ -47,6 +53,33 @@ const (
 	AgeRatingT
 	AgeRatingM
 	AgeRatingAO
+	AgeRatingCeroA
+	AgeRatingCeroB
+	AgeRatingCeroC
+	AgeRatingCeroD
+	AgeRatingCeroZ
+	AgeRatingUSK0
+	AgeRatingUSK6
+	AgeRatingUSK12
+	AgeRatingUSK16
+	AgeRatingUSK18
+	AgeRatingGRACAll
+	AgeRatingGRACTwelve
+	AgeRatingGRACFifteen
+	AgeRatingGRACEighteen
+	AgeRatingGRACTesting
+	AgeRatingClassIndL
+	AgeRatingClassIndTen
+	AgeRatingClassIndTwelve
+	AgeRatingClassIndFourteen
+	AgeRatingClassIndSixteen
+	AgeRatingClassIndEighteen
+	AgeRatingAcbG
+	AgeRatingAcbPG
+	AgeRatingAcbM
+	AgeRatingAcbMA15
+	AgeRatingAcbR18
+	AgeRatingAcbRC
 )
 
 // AgeRatingService handles all the API calls for the IGDB AgeRating endpoint.
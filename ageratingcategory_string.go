@@ -4,9 +4,9 @@ package igdb
 
 import "strconv"
 
-const _AgeRatingCategory_name = "AgeRatingESRBAgeRatingPEGI"
+const _AgeRatingCategory_name = "AgeRatingESRBAgeRatingPEGIAgeRatingCEROAgeRatingUSKAgeRatingGRACAgeRatingClassIndAgeRatingACB"
 
-var _AgeRatingCategory_index = [...]uint8{0, 13, 26}
+var _AgeRatingCategory_index = [...]uint8{0, 13, 26, 39, 51, 64, 81, 93}
 
 func (i AgeRatingCategory) String() string {
 	i -= 1
@@ -16,9 +16,9 @@ func (i AgeRatingCategory) String() string {
 	return _AgeRatingCategory_name[_AgeRatingCategory_index[i]:_AgeRatingCategory_index[i+1]]
 }
 
-const _AgeRatingEnum_name = "AgeRatingThreeAgeRatingSevenAgeRatingTwelveAgeRatingSixteenAgeRatingEighteenAgeRatingRPAgeRatingECAgeRatingEAgeRatingE10AgeRatingTAgeRatingMAgeRatingAO"
+const _AgeRatingEnum_name = "AgeRatingThreeAgeRatingSevenAgeRatingTwelveAgeRatingSixteenAgeRatingEighteenAgeRatingRPAgeRatingECAgeRatingEAgeRatingE10AgeRatingTAgeRatingMAgeRatingAOAgeRatingCeroAAgeRatingCeroBAgeRatingCeroCAgeRatingCeroDAgeRatingCeroZAgeRatingUSK0AgeRatingUSK6AgeRatingUSK12AgeRatingUSK16AgeRatingUSK18AgeRatingGRACAllAgeRatingGRACTwelveAgeRatingGRACFifteenAgeRatingGRACEighteenAgeRatingGRACTestingAgeRatingClassIndLAgeRatingClassIndTenAgeRatingClassIndTwelveAgeRatingClassIndFourteenAgeRatingClassIndSixteenAgeRatingClassIndEighteenAgeRatingAcbGAgeRatingAcbPGAgeRatingAcbMAgeRatingAcbMA15AgeRatingAcbR18AgeRatingAcbRC"
 
-var _AgeRatingEnum_index = [...]uint8{0, 14, 28, 43, 59, 76, 87, 98, 108, 120, 130, 140, 151}
+var _AgeRatingEnum_index = [...]uint16{0, 14, 28, 43, 59, 76, 87, 98, 108, 120, 130, 140, 151, 165, 179, 193, 207, 221, 234, 247, 261, 275, 289, 305, 324, 344, 365, 385, 403, 423, 446, 471, 495, 520, 533, 547, 560, 576, 591, 605}
 
 func (i AgeRatingEnum) String() string {
 	i -= 1
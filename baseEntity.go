@@ -1,10 +1,10 @@
 package igdb
 
 type BaseEntity struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	URL       string `json:"url"`
-	CreatedAt int    `json:"created_at"` // Unix time in milliseconds
-	UpdatedAt int    `json:"updated_at"` // Unix time in milliseconds
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	Slug      string   `json:"slug"`
+	URL       string   `json:"url"`
+	CreatedAt UnixTime `json:"created_at"`
+	UpdatedAt UnixTime `json:"updated_at"`
 }
@@ -0,0 +1,57 @@
+package igdb
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// batchSize is the largest number of entities requested by a single call
+// that batches IDs, pages through results, or syncs an endpoint. It is the
+// limit the IGDB allows the free account tier, the lowest of every tier, so
+// no request is rejected for asking for too many results.
+const batchSize = 500
+
+// batchIDs calls fn with consecutive batches of at most batchSize of the
+// provided IDs, stopping at the first error.
+func batchIDs(ids []int, fn func(batch []int) error) error {
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > batchSize {
+			n = batchSize
+		}
+
+		if err := fn(ids[:n]); err != nil {
+			return err
+		}
+		ids = ids[n:]
+	}
+
+	return nil
+}
+
+// indexAll calls index with the provided options for consecutive pages of
+// results ordered by ID, each page starting after the last ID of the page
+// before it, until a page holds fewer than batchSize results. index returns
+// the number of results in its page and the ID of the last one. Paging by ID
+// rather than by offset reaches every result regardless of the largest
+// offset the IGDB allows.
+func indexAll(index func(opts ...Option) (n int, last int, err error), opts ...Option) error {
+	for last := 0; ; {
+		n, next, err := index(append(opts,
+			SetFilter("id", OpGreaterThan, strconv.Itoa(last)),
+			SetOrder("id", OrderAscending),
+			SetLimit(batchSize),
+		)...)
+		if errors.Cause(err) == ErrNoResults {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if n < batchSize || next <= last {
+			return nil
+		}
+		last = next
+	}
+}
@@ -0,0 +1,76 @@
+package igdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBatchIDs(t *testing.T) {
+	ids := make([]int, batchSize*2+1)
+
+	var sizes []int
+	err := batchIDs(ids, func(batch []int) error {
+		sizes = append(sizes, len(batch))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []int{batchSize, batchSize, 1}
+	if !reflect.DeepEqual(sizes, want) {
+		t.Errorf("got: <%v>, want: <%v>", sizes, want)
+	}
+}
+
+func TestIndexAll(t *testing.T) {
+	full := make([]string, batchSize)
+	for i := range full {
+		full[i] = fmt.Sprintf(`{"id": %d}`, i+1)
+	}
+	pages := []string{
+		"[" + strings.Join(full, ",") + "]",
+		`[{"id": 501}, {"id": 503}]`,
+		`[]`,
+	}
+
+	var calls []string
+	ts, c, _ := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		calls = append(calls, string(b))
+		fmt.Fprint(w, pages[n-1])
+	})
+	defer ts.Close()
+
+	var ids []int
+	err := indexAll(func(opts ...Option) (int, int, error) {
+		page, err := c.Companies.Index(opts...)
+		if len(page) == 0 {
+			return 0, 0, err
+		}
+		for _, comp := range page {
+			ids = append(ids, comp.ID)
+		}
+		return len(page), page[len(page)-1].ID, err
+	}, SetFields("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ids) != batchSize+2 || ids[batchSize+1] != 503 {
+		t.Errorf("got: <%v> IDs, want: <%v> ending with <%v>", len(ids), batchSize+2, 503)
+	}
+
+	// The short second page ends the calls without requesting an empty one.
+	want := []string{
+		"fields id; limit 500; sort id asc; where id > 0; ",
+		"fields id; limit 500; sort id asc; where id > 500; ",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got: <%q>, want: <%q>", calls, want)
+	}
+}
@@ -70,6 +70,20 @@ func (cs *CharacterService) Get(id int, opts ...Option) (*Character, error) {
 	return ch[0], nil
 }
 
+// CharacterBySlug returns a single Character identified by the provided slug
+// using the provided CharacterReader, such as the Characters field of a
+// Client. Provide the SetFields functional option if you need to specify which
+// fields to retrieve. If the slug does not match any Characters, an error is
+// returned.
+func CharacterBySlug(r CharacterReader, slug string, opts ...Option) (*Character, error) {
+	ch, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Character with slug %s", slug)
+	}
+
+	return ch[0], nil
+}
+
 // List returns a list of Characters identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Character is ignored. If none of the IDs
@@ -37,6 +37,20 @@ func (cs *CollectionService) Get(id int, opts ...Option) (*Collection, error) {
 	return col[0], nil
 }
 
+// CollectionBySlug returns a single Collection identified by the provided slug
+// using the provided CollectionReader, such as the Collections field of a
+// Client. Provide the SetFields functional option if you need to specify which
+// fields to retrieve. If the slug does not match any Collections, an error is
+// returned.
+func CollectionBySlug(r CollectionReader, slug string, opts ...Option) (*Collection, error) {
+	col, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Collection with slug %s", slug)
+	}
+
+	return col[0], nil
+}
+
 // List returns a list of Collections identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Collection is ignored. If none of the IDs
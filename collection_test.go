@@ -58,6 +58,49 @@ func TestCollectionService_Get(t *testing.T) {
 	}
 }
 
+func TestCollectionBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testCollectionGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Collection, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name           string
+		file           string
+		slug           string
+		opts           []Option
+		wantCollection *Collection
+		wantErr        error
+	}{
+		{"Valid response", testCollectionGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			col, err := CollectionBySlug(c.Collections, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(col, test.wantCollection) {
+				t.Errorf("got: <%v>, \nwant: <%v>", col, test.wantCollection)
+			}
+		})
+	}
+}
+
 func TestCollectionService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testCollectionList)
 	if err != nil {
@@ -15,7 +15,7 @@ import (
 type Company struct {
 	BaseEntity
 
-	ChangeDate         int          `json:"change_date"`
+	ChangeDate         UnixTime     `json:"change_date"`
 	ChangeDateCategory DateCategory `json:"change_date_category"`
 	ChangedCompanyID   int          `json:"changed_company_id"`
 	Country            int          `json:"country"`
@@ -24,7 +24,7 @@ type Company struct {
 	Logo               int          `json:"logo"`
 	Parent             int          `json:"parent"`
 	Published          []int        `json:"published"`
-	StartDate          int          `json:"start_date"`
+	StartDate          UnixTime     `json:"start_date"`
 	StartDateCategory  DateCategory `json:"start_date_category"`
 	Websites           []int        `json:"websites"`
 }
@@ -51,6 +51,19 @@ func (cs *CompanyService) Get(id int, opts ...Option) (*Company, error) {
 	return comp[0], nil
 }
 
+// CompanyBySlug returns a single Company identified by the provided slug using
+// the provided CompanyReader, such as the Companies field of a Client. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Companies, an error is returned.
+func CompanyBySlug(r CompanyReader, slug string, opts ...Option) (*Company, error) {
+	comp, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Company with slug %s", slug)
+	}
+
+	return comp[0], nil
+}
+
 // List returns a list of Companies identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Company is ignored. If none of the IDs
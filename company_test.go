@@ -57,6 +57,49 @@ func TestCompanyService_Get(t *testing.T) {
 	}
 }
 
+func TestCompanyBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testCompanyGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Company, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name        string
+		file        string
+		slug        string
+		opts        []Option
+		wantCompany *Company
+		wantErr     error
+	}{
+		{"Valid response", testCompanyGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			comp, err := CompanyBySlug(c.Companies, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(comp, test.wantCompany) {
+				t.Errorf("got: <%v>, \nwant: <%v>", comp, test.wantCompany)
+			}
+		})
+	}
+}
+
 func TestCompanyService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testCompanyList)
 	if err != nil {
@@ -0,0 +1,307 @@
+package igdb
+
+import (
+	"sort"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// CompanyTree is a Company along with the tree of Companies it owns.
+type CompanyTree struct {
+	Company      *Company
+	Subsidiaries []*CompanyTree
+}
+
+// Size returns the number of Companies in the tree, including its root.
+func (t *CompanyTree) Size() int {
+	n := 1
+	for _, sub := range t.Subsidiaries {
+		n += sub.Size()
+	}
+
+	return n
+}
+
+// CompanyHierarchy contains the Companies that own a Company and the
+// Companies it owns, as described by the Parent of each Company.
+type CompanyHierarchy struct {
+	Company *Company
+	// Ancestors holds the parent of the Company, then the parent of that
+	// parent, and so on up to the topmost owner.
+	Ancestors []*Company
+	// Subsidiaries holds the trees of Companies whose parent is the Company.
+	Subsidiaries []*CompanyTree
+	// Cyclic is true if the parents of the Companies loop back on
+	// themselves. The loop is cut at the first Company seen twice.
+	Cyclic bool
+}
+
+// Owner returns the topmost owner of the Company, or the Company itself if
+// it has no parent.
+func (h *CompanyHierarchy) Owner() *Company {
+	if len(h.Ancestors) == 0 {
+		return h.Company
+	}
+
+	return h.Ancestors[len(h.Ancestors)-1]
+}
+
+// LoadCompanyHierarchy returns the CompanyHierarchy of the Company
+// identified by the provided IGDB ID, retrieved by the provided
+// CompanyReader. Parents that do not match any Company end the chain of
+// Ancestors. If the ID does not match any Company, an error is returned.
+func LoadCompanyHierarchy(r CompanyReader, id int) (*CompanyHierarchy, error) {
+	comp, err := r.Get(id, SetFields("*"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get hierarchy of Company with ID %v", id)
+	}
+
+	h := &CompanyHierarchy{Company: comp}
+	seen := map[int]bool{comp.ID: true}
+
+	for cur := comp; cur.Parent != 0; {
+		if seen[cur.Parent] {
+			h.Cyclic = true
+			break
+		}
+
+		p, err := r.Get(cur.Parent, SetFields("*"))
+		if errors.Cause(err) == ErrNoResults {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get ancestors of Company with ID %v", id)
+		}
+
+		seen[p.ID] = true
+		h.Ancestors = append(h.Ancestors, p)
+		cur = p
+	}
+
+	root := &CompanyTree{Company: comp}
+	trees := map[int]*CompanyTree{comp.ID: root}
+	frontier := []int{comp.ID}
+
+	for len(frontier) > 0 {
+		subs, err := companiesReferencing(r, "parent", frontier)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get subsidiaries of Company with ID %v", id)
+		}
+
+		frontier = nil
+		for _, sub := range subs {
+			if seen[sub.ID] {
+				h.Cyclic = true
+				continue
+			}
+			seen[sub.ID] = true
+
+			t := &CompanyTree{Company: sub}
+			trees[sub.ID] = t
+			trees[sub.Parent].Subsidiaries = append(trees[sub.Parent].Subsidiaries, t)
+			frontier = append(frontier, sub.ID)
+		}
+	}
+	h.Subsidiaries = root.Subsidiaries
+
+	return h, nil
+}
+
+// LineageEntry is a Company in a CompanyLineage.
+type LineageEntry struct {
+	Company *Company
+	// Start is the date the Company was founded.
+	Start FuzzyDate
+	// Changed is the date the Company changed into its Successor. It is TBD
+	// if the Company has not changed.
+	Changed FuzzyDate
+	// Successor is the ID of the Company this Company changed into, or 0 if
+	// it has not changed.
+	Successor int
+	// Predecessors holds the IDs of the Companies that changed into this
+	// Company.
+	Predecessors []int
+}
+
+// Merged returns true if more than one Company changed into the Company.
+func (e *LineageEntry) Merged() bool {
+	return len(e.Predecessors) > 1
+}
+
+// CompanyLineage contains the Companies a Company was renamed or merged from
+// and into, as described by the ChangedCompanyID of each Company.
+type CompanyLineage struct {
+	Company *Company
+	// Entries holds every Company of the lineage, ordered by the date they
+	// changed, then by the date they were founded. Companies that have not
+	// changed come last.
+	Entries []*LineageEntry
+	// Cyclic is true if the changes between the Companies loop back on
+	// themselves.
+	Cyclic bool
+}
+
+// Entry returns the LineageEntry of the Company with the provided ID, or nil
+// if the Company is not part of the lineage.
+func (l *CompanyLineage) Entry(id int) *LineageEntry {
+	for _, e := range l.Entries {
+		if e.Company.ID == id {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// Current returns the Company the Company of the lineage has most recently
+// changed into, or the Company itself if it has not changed.
+func (l *CompanyLineage) Current() *Company {
+	cur := l.Entry(l.Company.ID)
+	seen := map[int]bool{cur.Company.ID: true}
+
+	for cur.Successor != 0 && !seen[cur.Successor] {
+		next := l.Entry(cur.Successor)
+		if next == nil {
+			break
+		}
+		seen[next.Company.ID] = true
+		cur = next
+	}
+
+	return cur.Company
+}
+
+// LoadCompanyLineage returns the CompanyLineage of the Company identified by
+// the provided IGDB ID, retrieved by the provided CompanyReader. The lineage
+// holds every Company reachable by following changes forward to successors
+// and backward to predecessors, which includes the other parties of a
+// merger. If the ID does not match any Company, an error is returned.
+func LoadCompanyLineage(r CompanyReader, id int) (*CompanyLineage, error) {
+	comp, err := r.Get(id, SetFields("*"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get lineage of Company with ID %v", id)
+	}
+
+	known := map[int]*Company{comp.ID: comp}
+	frontier := []*Company{comp}
+
+	for len(frontier) > 0 {
+		var ids, next []int
+		for _, c := range frontier {
+			ids = append(ids, c.ID)
+			if c.ChangedCompanyID != 0 && known[c.ChangedCompanyID] == nil {
+				next = append(next, c.ChangedCompanyID)
+			}
+		}
+
+		var found []*Company
+		err := listDetail(dedupeIDs(next), func(ids []int, opts ...Option) error {
+			v, err := r.List(ids, opts...)
+			found = append(found, v...)
+			return err
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get successors of Companies with IDs %v", ids)
+		}
+
+		preds, err := companiesReferencing(r, "changed_company_id", ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get predecessors of Companies with IDs %v", ids)
+		}
+
+		frontier = nil
+		for _, c := range append(found, preds...) {
+			if known[c.ID] == nil {
+				known[c.ID] = c
+				frontier = append(frontier, c)
+			}
+		}
+	}
+
+	l := &CompanyLineage{Company: comp}
+	entries := make(map[int]*LineageEntry, len(known))
+	for _, c := range known {
+		e := &LineageEntry{
+			Company:   c,
+			Start:     c.StartFuzzyDate(),
+			Successor: c.ChangedCompanyID,
+		}
+		if c.ChangedCompanyID != 0 {
+			e.Changed = c.ChangeFuzzyDate()
+		}
+		entries[c.ID] = e
+		l.Entries = append(l.Entries, e)
+	}
+
+	for _, e := range l.Entries {
+		if succ := entries[e.Successor]; succ != nil {
+			succ.Predecessors = append(succ.Predecessors, e.Company.ID)
+		}
+		l.Cyclic = l.Cyclic || changesLoop(e.Company, known)
+	}
+
+	sort.Slice(l.Entries, func(i, j int) bool {
+		a, b := l.Entries[i], l.Entries[j]
+		if a.Successor != 0 && b.Successor == 0 {
+			return true
+		}
+		if a.Successor == 0 && b.Successor != 0 {
+			return false
+		}
+		if c := a.Changed.Compare(b.Changed); c != 0 {
+			return c < 0
+		}
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c < 0
+		}
+		return a.Company.ID < b.Company.ID
+	})
+	for _, e := range l.Entries {
+		sort.Ints(e.Predecessors)
+	}
+
+	return l, nil
+}
+
+// changesLoop returns true if following the changes of the provided Company
+// through the provided Companies leads back to a Company already passed.
+func changesLoop(c *Company, known map[int]*Company) bool {
+	seen := make(map[int]bool)
+	for c != nil && c.ChangedCompanyID != 0 {
+		if seen[c.ID] {
+			return true
+		}
+		seen[c.ID] = true
+		c = known[c.ChangedCompanyID]
+	}
+
+	return false
+}
+
+// companiesReferencing returns every Company whose provided reference field
+// holds any of the provided IDs, ordered by ID. The Companies are retrieved
+// in batches of IDs and pages of results.
+func companiesReferencing(r CompanyReader, field string, ids []int) ([]*Company, error) {
+	var comps []*Company
+
+	err := batchIDs(ids, func(batch []int) error {
+		return indexAll(func(opts ...Option) (int, int, error) {
+			page, err := r.Index(opts...)
+			if len(page) == 0 {
+				return 0, 0, err
+			}
+			comps = append(comps, page...)
+			return len(page), page[len(page)-1].ID, err
+		}, SetFields("*"), SetFilter(field, OpContainsAtLeast, sliceconv.Itoa(batch)...))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(comps, func(i, j int) bool {
+		return comps[i].ID < comps[j].ID
+	})
+
+	return comps, nil
+}
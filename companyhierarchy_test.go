@@ -0,0 +1,191 @@
+package igdb_test
+
+import (
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+	"github.com/pkg/errors"
+)
+
+// testCompanyServer returns a Server loaded with a tree of Companies, a
+// chain of renamed and merged Companies, and Companies whose parents and
+// changes loop.
+func testCompanyServer(t *testing.T) *igdbtest.Server {
+	srv := igdbtest.NewServer()
+
+	err := srv.LoadAll(map[string]string{string(igdb.EndpointCompany): `[
+		{"id": 1, "name": "Holding"},
+		{"id": 2, "name": "Publisher", "parent": 1},
+		{"id": 3, "name": "Studio", "parent": 2},
+		{"id": 4, "name": "Team A", "parent": 3},
+		{"id": 5, "name": "Team B", "parent": 3},
+		{"id": 6, "name": "Team A Support", "parent": 4},
+		{"id": 7, "name": "Missing Parent", "parent": 99},
+		{"id": 10, "name": "Loop A", "parent": 11},
+		{"id": 11, "name": "Loop B", "parent": 10},
+		{"id": 19, "name": "Older A", "start_date": 631152000, "start_date_category": 2,
+			"changed_company_id": 20, "change_date": 788918400, "change_date_category": 2},
+		{"id": 20, "name": "Old A", "start_date": 788918400, "start_date_category": 2,
+			"changed_company_id": 22, "change_date": 978307200, "change_date_category": 2},
+		{"id": 21, "name": "Old B", "changed_company_id": 22, "change_date": 991353600, "change_date_category": 1},
+		{"id": 22, "name": "Merged", "start_date": 991353600, "start_date_category": 1,
+			"changed_company_id": 23, "change_date": 1262304000, "change_date_category": 2},
+		{"id": 23, "name": "Current", "start_date": 1262304000, "start_date_category": 2},
+		{"id": 30, "name": "Renamed A", "changed_company_id": 31},
+		{"id": 31, "name": "Renamed B", "changed_company_id": 30}
+	]`})
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	return srv
+}
+
+// companyIDs returns the IDs of the provided Companies.
+func companyIDs(comps []*igdb.Company) []int {
+	var ids []int
+	for _, c := range comps {
+		ids = append(ids, c.ID)
+	}
+
+	return ids
+}
+
+func TestLoadCompanyHierarchy(t *testing.T) {
+	srv := testCompanyServer(t)
+	defer srv.Close()
+
+	c := srv.NewClient()
+
+	h, err := igdb.LoadCompanyHierarchy(c.Companies, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := companyIDs(h.Ancestors); !equalInts(got, []int{2, 1}) {
+		t.Errorf("got: <%v>, want: <%v>", got, []int{2, 1})
+	}
+	if h.Owner().ID != 1 {
+		t.Errorf("got: <%v>, want: <%v>", h.Owner().ID, 1)
+	}
+	if len(h.Subsidiaries) != 2 {
+		t.Fatalf("got: <%v>, want: <%v>", len(h.Subsidiaries), 2)
+	}
+	if h.Subsidiaries[0].Company.ID != 4 || h.Subsidiaries[0].Size() != 2 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", h.Subsidiaries[0].Company.ID, h.Subsidiaries[0].Size(), 4, 2)
+	}
+	if h.Subsidiaries[1].Company.ID != 5 || len(h.Subsidiaries[1].Subsidiaries) != 0 {
+		t.Errorf("got: <%v>, want a childless Company: <%v>", h.Subsidiaries[1].Company.ID, 5)
+	}
+	if h.Cyclic {
+		t.Errorf("got: <%v>, want: <%v>", h.Cyclic, false)
+	}
+
+	h, err = igdb.LoadCompanyHierarchy(c.Companies, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Ancestors) != 0 || h.Owner().ID != 1 {
+		t.Errorf("got: <%v>, want no ancestors", companyIDs(h.Ancestors))
+	}
+	if len(h.Subsidiaries) != 1 || h.Subsidiaries[0].Size() != 5 {
+		t.Errorf("got: <%v>, want a single tree of: <%v>", h.Subsidiaries, 5)
+	}
+
+	h, err = igdb.LoadCompanyHierarchy(c.Companies, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Ancestors) != 0 || h.Cyclic {
+		t.Errorf("got: <%v, %v>, want a missing parent ignored", companyIDs(h.Ancestors), h.Cyclic)
+	}
+
+	h, err = igdb.LoadCompanyHierarchy(c.Companies, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.Cyclic {
+		t.Errorf("got: <%v>, want: <%v>", h.Cyclic, true)
+	}
+	if got := companyIDs(h.Ancestors); !equalInts(got, []int{11}) || len(h.Subsidiaries) != 0 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", got, len(h.Subsidiaries), []int{11}, 0)
+	}
+
+	if _, err := igdb.LoadCompanyHierarchy(c.Companies, 98); errors.Cause(err) != igdb.ErrNoResults {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNoResults)
+	}
+}
+
+func TestLoadCompanyLineage(t *testing.T) {
+	srv := testCompanyServer(t)
+	defer srv.Close()
+
+	c := srv.NewClient()
+
+	l, err := igdb.LoadCompanyLineage(c.Companies, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for _, e := range l.Entries {
+		got = append(got, e.Company.ID)
+	}
+	if want := []int{19, 20, 21, 22, 23}; !equalInts(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if l.Current().ID != 23 {
+		t.Errorf("got: <%v>, want: <%v>", l.Current().ID, 23)
+	}
+	if l.Cyclic {
+		t.Errorf("got: <%v>, want: <%v>", l.Cyclic, false)
+	}
+
+	merged := l.Entry(22)
+	if !merged.Merged() || !equalInts(merged.Predecessors, []int{20, 21}) {
+		t.Errorf("got: <%v>, want: <%v>", merged.Predecessors, []int{20, 21})
+	}
+	if merged.Changed.String() != "2010" || merged.Start.String() != "2001-Jun" {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", merged.Changed, merged.Start, "2010", "2001-Jun")
+	}
+	if e := l.Entry(23); e.Successor != 0 || !e.Changed.IsTBD() {
+		t.Errorf("got: <%v, %v>, want an unchanged Company", e.Successor, e.Changed)
+	}
+	if e := l.Entry(20); e.Merged() || !equalInts(e.Predecessors, []int{19}) {
+		t.Errorf("got: <%v>, want: <%v>", e.Predecessors, []int{19})
+	}
+
+	l, err = igdb.LoadCompanyLineage(c.Companies, 23)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Entries) != 5 || l.Current().ID != 23 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", len(l.Entries), l.Current().ID, 5, 23)
+	}
+
+	l, err = igdb.LoadCompanyLineage(c.Companies, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Entries) != 1 || l.Current().ID != 1 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", len(l.Entries), l.Current().ID, 1, 1)
+	}
+
+	l, err = igdb.LoadCompanyLineage(c.Companies, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Cyclic || len(l.Entries) != 2 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", l.Cyclic, len(l.Entries), true, 2)
+	}
+	if l.Current().ID != 31 {
+		t.Errorf("got: <%v>, want: <%v>", l.Current().ID, 31)
+	}
+
+	if _, err := igdb.LoadCompanyLineage(c.Companies, -1); errors.Cause(err) != igdb.ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNegativeID)
+	}
+}
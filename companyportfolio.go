@@ -0,0 +1,292 @@
+package igdb
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// CompanyRole is the part a Company played in making a Game.
+type CompanyRole string
+
+// Available roles of a Company as given by an InvolvedCompany
+const (
+	RoleDeveloper  CompanyRole = "developer"
+	RolePublisher  CompanyRole = "publisher"
+	RolePorting    CompanyRole = "porting"
+	RoleSupporting CompanyRole = "supporting"
+)
+
+// CompanyRoles lists every CompanyRole.
+var CompanyRoles = []CompanyRole{
+	RoleDeveloper,
+	RolePublisher,
+	RolePorting,
+	RoleSupporting,
+}
+
+// roles returns the CompanyRoles the InvolvedCompany gives its Company.
+func (ic *InvolvedCompany) roles() []CompanyRole {
+	var roles []CompanyRole
+	if ic.Developer {
+		roles = append(roles, RoleDeveloper)
+	}
+	if ic.Publisher {
+		roles = append(roles, RolePublisher)
+	}
+	if ic.Porting {
+		roles = append(roles, RolePorting)
+	}
+	if ic.Supporting {
+		roles = append(roles, RoleSupporting)
+	}
+
+	return roles
+}
+
+// PortfolioOptions filter the Games of a Portfolio. The zero value keeps
+// every Game.
+type PortfolioOptions struct {
+	// Platforms keeps only the Games released on any of the provided
+	// platforms.
+	Platforms []int
+	// FromYear keeps only the Games first released in or after the provided
+	// year. Games without a release date are left out.
+	FromYear int
+	// ToYear keeps only the Games first released in or before the provided
+	// year. Games without a release date are left out.
+	ToYear int
+}
+
+// keep returns true if the provided Game passes the filters.
+func (o PortfolioOptions) keep(g *Game) bool {
+	if len(o.Platforms) > 0 {
+		found := false
+		for _, want := range o.Platforms {
+			for _, p := range g.Platforms {
+				found = found || p == want
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if o.FromYear == 0 && o.ToYear == 0 {
+		return true
+	}
+	if g.FirstReleaseDate.IsZero() {
+		return false
+	}
+
+	y := g.FirstReleaseDate.Time().Year()
+	if o.FromYear != 0 && y < o.FromYear {
+		return false
+	}
+	if o.ToYear != 0 && y > o.ToYear {
+		return false
+	}
+
+	return true
+}
+
+// PortfolioGame is a Game in a Portfolio.
+type PortfolioGame struct {
+	Game *Game
+	// Released is the date the Game was first released.
+	Released FuzzyDate
+	// Roles holds every role the Company played in making the Game.
+	Roles []CompanyRole
+}
+
+// PortfolioMismatch is a Game the Company lists as developed or published
+// without a matching InvolvedCompany, or the other way around.
+type PortfolioMismatch struct {
+	// Game is the ID of the Game.
+	Game int
+	// Role is either RoleDeveloper or RolePublisher.
+	Role CompanyRole
+	// Listed is true if the Game is among the Developed or Published Games
+	// of the Company.
+	Listed bool
+	// Involved is true if an InvolvedCompany gives the Company the Role for
+	// the Game.
+	Involved bool
+}
+
+// PortfolioTotals counts the Games of a Company before any filters are
+// applied.
+type PortfolioTotals struct {
+	// Games is the number of distinct Games of the Company in any role.
+	Games int
+	// Developed is the number of Developed Games listed by the Company.
+	Developed int
+	// Published is the number of Published Games listed by the Company.
+	Published int
+	// Involved is the number of Games in each role given to the Company by
+	// InvolvedCompanies.
+	Involved map[CompanyRole]int
+}
+
+// Portfolio contains the Games a Company made, grouped by the role it
+// played in making them.
+type Portfolio struct {
+	Company *Company
+	// Roles holds the Games of each role, ordered by the date they were
+	// first released. Games without a release date come last. A Game is
+	// present in every role the Company played in making it.
+	Roles  map[CompanyRole][]*PortfolioGame
+	Totals PortfolioTotals
+	// Mismatches holds the Games whose Developed and Published listings
+	// disagree with the InvolvedCompanies of the Company.
+	Mismatches []PortfolioMismatch
+}
+
+// Games returns the Games of the Portfolio in the provided role.
+func (p *Portfolio) Games(role CompanyRole) []*PortfolioGame {
+	return p.Roles[role]
+}
+
+// Consistent returns true if the Developed and Published listings of the
+// Company agree with its InvolvedCompanies.
+func (p *Portfolio) Consistent() bool {
+	return len(p.Mismatches) == 0
+}
+
+// LoadPortfolio returns the Portfolio of the Company identified by the
+// provided IGDB ID with its Games filtered by the provided options. The
+// Company, its InvolvedCompanies, and its Games are retrieved through the
+// Reader fields of the provided Client. The roles of the Company are taken
+// from both its Developed and Published listings and from the
+// InvolvedCompanies that reference it. Games that cannot be found are
+// counted in the Totals but left out of the Roles. If the ID does not match
+// any Company, an error is returned.
+func LoadPortfolio(c *Client, id int, opts PortfolioOptions) (*Portfolio, error) {
+	comp, err := c.Companies.Get(id, SetFields("*"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get portfolio of Company with ID %v", id)
+	}
+
+	var ics []*InvolvedCompany
+	err = indexAll(func(o ...Option) (int, int, error) {
+		page, err := c.InvolvedCompanies.Index(o...)
+		if len(page) == 0 {
+			return 0, 0, err
+		}
+		ics = append(ics, page...)
+		return len(page), page[len(page)-1].ID, err
+	}, SetFields("*"), SetFilter("company", OpEquals, strconv.Itoa(comp.ID)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get involvement of Company with ID %v", id)
+	}
+
+	roles := make(map[int]map[CompanyRole]bool)
+	var ids []int
+	addRole := func(game int, role CompanyRole) {
+		if game == 0 {
+			return
+		}
+		if roles[game] == nil {
+			roles[game] = make(map[CompanyRole]bool)
+			ids = append(ids, game)
+		}
+		roles[game][role] = true
+	}
+
+	involved := map[CompanyRole]map[int]bool{}
+	for _, role := range CompanyRoles {
+		involved[role] = make(map[int]bool)
+	}
+	for _, ic := range ics {
+		for _, role := range ic.roles() {
+			if ic.Game != 0 {
+				involved[role][ic.Game] = true
+			}
+			addRole(ic.Game, role)
+		}
+	}
+
+	listed := map[CompanyRole]map[int]bool{
+		RoleDeveloper: make(map[int]bool),
+		RolePublisher: make(map[int]bool),
+	}
+	for _, g := range comp.Developed {
+		listed[RoleDeveloper][g] = true
+		addRole(g, RoleDeveloper)
+	}
+	for _, g := range comp.Published {
+		listed[RolePublisher][g] = true
+		addRole(g, RolePublisher)
+	}
+
+	p := &Portfolio{
+		Company: comp,
+		Roles:   make(map[CompanyRole][]*PortfolioGame),
+		Totals: PortfolioTotals{
+			Games:     len(ids),
+			Developed: len(listed[RoleDeveloper]),
+			Published: len(listed[RolePublisher]),
+			Involved:  make(map[CompanyRole]int),
+		},
+	}
+	for _, role := range CompanyRoles {
+		p.Totals.Involved[role] = len(involved[role])
+	}
+
+	sort.Ints(ids)
+	for _, game := range ids {
+		for _, role := range []CompanyRole{RoleDeveloper, RolePublisher} {
+			if listed[role][game] != involved[role][game] {
+				p.Mismatches = append(p.Mismatches, PortfolioMismatch{
+					Game:     game,
+					Role:     role,
+					Listed:   listed[role][game],
+					Involved: involved[role][game],
+				})
+			}
+		}
+	}
+
+	var games []*Game
+	err = listDetail(ids, func(batch []int, o ...Option) error {
+		v, err := c.Games.List(batch, o...)
+		games = append(games, v...)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games of Company with ID %v", id)
+	}
+
+	for _, g := range games {
+		if !opts.keep(g) {
+			continue
+		}
+
+		pg := &PortfolioGame{
+			Game:     g,
+			Released: NewFuzzyDate(g.FirstReleaseDate, DateYYYYMMMMDD),
+		}
+		for _, role := range CompanyRoles {
+			if roles[g.ID][role] {
+				pg.Roles = append(pg.Roles, role)
+				p.Roles[role] = append(p.Roles[role], pg)
+			}
+		}
+	}
+
+	for _, pgs := range p.Roles {
+		sort.Slice(pgs, func(i, j int) bool {
+			a, b := pgs[i], pgs[j]
+			if n := a.Released.Compare(b.Released); n != 0 {
+				return n < 0
+			}
+			if a.Game.Name != b.Game.Name {
+				return a.Game.Name < b.Game.Name
+			}
+			return a.Game.ID < b.Game.ID
+		})
+	}
+
+	return p, nil
+}
@@ -0,0 +1,164 @@
+package igdb_test
+
+import (
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+	"github.com/pkg/errors"
+)
+
+// testPortfolioServer returns a Server loaded with a Company, the
+// InvolvedCompanies that reference it, and its Games.
+func testPortfolioServer(t *testing.T) *igdbtest.Server {
+	srv := igdbtest.NewServer()
+
+	err := srv.LoadAll(map[string]string{
+		string(igdb.EndpointCompany): `[{"id": 3, "name": "Studio", "developed": [100, 101, 103], "published": [101]},
+			{"id": 4, "name": "Publisher"}]`,
+		string(igdb.EndpointInvolvedCompany): `[
+			{"id": 1, "company": 3, "game": 100, "developer": true},
+			{"id": 2, "company": 3, "game": 101, "developer": true, "publisher": true},
+			{"id": 3, "company": 3, "game": 102, "porting": true},
+			{"id": 4, "company": 3, "game": 104, "publisher": true},
+			{"id": 5, "company": 4, "game": 100, "publisher": true}
+		]`,
+		string(igdb.EndpointGame): `[
+			{"id": 100, "name": "A", "first_release_date": 1420070400, "platforms": [6]},
+			{"id": 101, "name": "B", "first_release_date": 1325376000, "platforms": [6, 48]},
+			{"id": 102, "name": "C", "platforms": [48]},
+			{"id": 104, "name": "D", "first_release_date": 1514764800, "platforms": [48]}
+		]`,
+	})
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	return srv
+}
+
+// portfolioIDs returns the IDs of the Games of the provided role.
+func portfolioIDs(p *igdb.Portfolio, role igdb.CompanyRole) []int {
+	var ids []int
+	for _, pg := range p.Games(role) {
+		ids = append(ids, pg.Game.ID)
+	}
+
+	return ids
+}
+
+func TestLoadPortfolio(t *testing.T) {
+	srv := testPortfolioServer(t)
+	defer srv.Close()
+
+	c := srv.NewClient()
+
+	var tests = []struct {
+		name string
+		opts igdb.PortfolioOptions
+		want map[igdb.CompanyRole][]int
+	}{
+		{"No filters", igdb.PortfolioOptions{}, map[igdb.CompanyRole][]int{
+			igdb.RoleDeveloper: {101, 100},
+			igdb.RolePublisher: {101, 104},
+			igdb.RolePorting:   {102},
+		}},
+		{"Platform", igdb.PortfolioOptions{Platforms: []int{48}}, map[igdb.CompanyRole][]int{
+			igdb.RoleDeveloper: {101},
+			igdb.RolePublisher: {101, 104},
+			igdb.RolePorting:   {102},
+		}},
+		{"From year", igdb.PortfolioOptions{FromYear: 2014}, map[igdb.CompanyRole][]int{
+			igdb.RoleDeveloper: {100},
+			igdb.RolePublisher: {104},
+		}},
+		{"Year range", igdb.PortfolioOptions{FromYear: 2012, ToYear: 2015}, map[igdb.CompanyRole][]int{
+			igdb.RoleDeveloper: {101, 100},
+			igdb.RolePublisher: {101},
+		}},
+		{"No matches", igdb.PortfolioOptions{Platforms: []int{130}}, map[igdb.CompanyRole][]int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := igdb.LoadPortfolio(c, 3, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, role := range igdb.CompanyRoles {
+				if got := portfolioIDs(p, role); !equalInts(got, test.want[role]) {
+					t.Errorf("%s: got: <%v>, want: <%v>", role, got, test.want[role])
+				}
+			}
+
+			if p.Totals.Games != 5 {
+				t.Errorf("got: <%v>, want: <%v>", p.Totals.Games, 5)
+			}
+		})
+	}
+}
+
+func TestLoadPortfolioTotals(t *testing.T) {
+	srv := testPortfolioServer(t)
+	defer srv.Close()
+
+	c := srv.NewClient()
+
+	p, err := igdb.LoadPortfolio(c, 3, igdb.PortfolioOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Totals.Developed != 3 || p.Totals.Published != 1 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", p.Totals.Developed, p.Totals.Published, 3, 1)
+	}
+	wantInvolved := map[igdb.CompanyRole]int{
+		igdb.RoleDeveloper:  2,
+		igdb.RolePublisher:  2,
+		igdb.RolePorting:    1,
+		igdb.RoleSupporting: 0,
+	}
+	for role, want := range wantInvolved {
+		if got := p.Totals.Involved[role]; got != want {
+			t.Errorf("%s: got: <%v>, want: <%v>", role, got, want)
+		}
+	}
+
+	wantMismatches := []igdb.PortfolioMismatch{
+		{Game: 103, Role: igdb.RoleDeveloper, Listed: true, Involved: false},
+		{Game: 104, Role: igdb.RolePublisher, Listed: false, Involved: true},
+	}
+	if len(p.Mismatches) != len(wantMismatches) {
+		t.Fatalf("got: <%v>, want: <%v>", p.Mismatches, wantMismatches)
+	}
+	for i := range wantMismatches {
+		if p.Mismatches[i] != wantMismatches[i] {
+			t.Errorf("got: <%v>, want: <%v>", p.Mismatches[i], wantMismatches[i])
+		}
+	}
+	if p.Consistent() {
+		t.Errorf("got: <%v>, want: <%v>", true, false)
+	}
+
+	b := p.Games(igdb.RoleDeveloper)[0]
+	if b.Game.ID != 101 || len(b.Roles) != 2 || b.Released.String() != "2012-Jan-01" {
+		t.Errorf("got: <%v, %v, %v>, want: <%v, %v, %v>", b.Game.ID, b.Roles, b.Released, 101, 2, "2012-Jan-01")
+	}
+
+	p, err = igdb.LoadPortfolio(c, 4, igdb.PortfolioOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := portfolioIDs(p, igdb.RolePublisher); !equalInts(got, []int{100}) {
+		t.Errorf("got: <%v>, want: <%v>", got, []int{100})
+	}
+	if len(p.Mismatches) != 1 || p.Mismatches[0].Game != 100 {
+		t.Errorf("got: <%v>, want a mismatch for: <%v>", p.Mismatches, 100)
+	}
+
+	if _, err := igdb.LoadPortfolio(c, 99, igdb.PortfolioOptions{}); errors.Cause(err) != igdb.ErrNoResults {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNoResults)
+	}
+}
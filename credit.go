@@ -18,13 +18,13 @@ type Credit struct {
 	Comment               string         `json:"comment"`
 	Company               int            `json:"company"`
 	Country               int            `json:"country"`
-	CreatedAt             int            `json:"created_at"`
+	CreatedAt             UnixTime       `json:"created_at"`
 	CreditedName          string         `json:"credited_name"`
 	Game                  int            `json:"game"`
 	Person                int            `json:"person"`
 	PersonTitle           int            `json:"person_title"`
 	Position              int            `json:"position"`
-	UpdatedAt             int            `json:"updated_at"`
+	UpdatedAt             UnixTime       `json:"updated_at"`
 }
 
 // CreditCategory specifies a specific job or role within a company.
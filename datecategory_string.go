@@ -15,9 +15,9 @@ func (i DateCategory) String() string {
 	return _DateCategory_name[_DateCategory_index[i]:_DateCategory_index[i+1]]
 }
 
-const _RegionCategory_name = "RegionEuropeRegionNorthAmericaRegionAustraliaRegionNewZealandRegionJapanRegionChinaRegionAsiaRegionWorldwide"
+const _RegionCategory_name = "RegionEuropeRegionNorthAmericaRegionAustraliaRegionNewZealandRegionJapanRegionChinaRegionAsiaRegionWorldwideRegionKoreaRegionBrazil"
 
-var _RegionCategory_index = [...]uint8{0, 12, 30, 45, 61, 72, 83, 93, 108}
+var _RegionCategory_index = [...]uint8{0, 12, 30, 45, 61, 72, 83, 93, 108, 119, 131}
 
 func (i RegionCategory) String() string {
 	i -= 1
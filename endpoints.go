@@ -1,5 +1,7 @@
 package igdb
 
+import "github.com/pkg/errors"
+
 type endpoint string
 
 // Public IGDB API endpoints
@@ -86,14 +88,9 @@ type Count struct {
 // getFields returns a list of fields that represent the
 // model of the data available at the given IGDB endpoint.
 func (c *Client) getFields(end endpoint) ([]string, error) {
-	req, err := c.request(end + "meta")
-	if err != nil {
-		return nil, err
-	}
-
 	var f []string
 
-	if err = c.send(req, &f); err != nil && err != ErrNoResults {
+	if err := c.do(end+"meta", &f); err != nil && errors.Cause(err) != ErrNoResults {
 		return nil, err
 	}
 
@@ -102,15 +99,9 @@ func (c *Client) getFields(end endpoint) ([]string, error) {
 
 // getCount returns the count of entities available for the given IGDB endpoint.
 func (c *Client) getCount(end endpoint, opts ...Option) (int, error) {
-	req, err := c.request(end+"count", opts...)
-	if err != nil {
-		return 0, err
-	}
-
 	var ct Count
 
-	err = c.send(req, &ct)
-	if err != nil {
+	if err := c.do(end+"count", &ct, opts...); err != nil {
 		return 0, err
 	}
 
@@ -0,0 +1,145 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate go run ./internal/genenum -dir . -out enumnames.go
+
+// ErrUnknownEnum occurs when parsing a name that does not match any value of
+// an enumerated type.
+var ErrUnknownEnum = errors.New("unknown enum name")
+
+// Enumerated types such as GameCategory and RegionCategory are encoded to JSON
+// as their IGDB numbers, the same way they are received from the IGDB. Each
+// enumerated type has a Name counterpart (e.g. GameCategoryName) that is
+// encoded as its snake_case name (e.g. dlc_addon) instead; convert a value to
+// it when writing JSON meant to be read by people. Both forms are always
+// accepted when decoding. Enumerated types always encode to their names as
+// text, and filters always use their numbers.
+
+// enumName pairs the value of an enumerated type with its snake_case name.
+type enumName struct {
+	value int
+	name  string
+}
+
+// EnumValue describes a single named value of an enumerated type.
+type EnumValue struct {
+	// Value is the IGDB number of the value.
+	Value int `json:"value"`
+	// Name is the snake_case name of the value (e.g. dlc_addon).
+	Name string `json:"name"`
+}
+
+// EnumTypes returns the names of every enumerated type in the registry
+// (e.g. GameCategory), sorted by name.
+func EnumTypes() []string {
+	var types []string
+	for typ := range enumRegistry {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// EnumValues returns every named value of the enumerated type with the
+// provided name (e.g. RegionCategory), such as for building a list of
+// choices. Nil is returned if the type is not in the registry.
+func EnumValues(typ string) []EnumValue {
+	names, ok := enumRegistry[typ]
+	if !ok {
+		return nil
+	}
+
+	vals := make([]EnumValue, len(names))
+	for i, n := range names {
+		vals[i] = EnumValue{Value: n.value, Name: n.name}
+	}
+
+	return vals
+}
+
+// isKnown returns true if the provided value has a name.
+func isKnown(names []enumName, v int) bool {
+	for _, n := range names {
+		if n.value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nameOf returns the name of the provided value, or the value as a number if
+// it has no name.
+func nameOf(names []enumName, v int) string {
+	for _, n := range names {
+		if n.value == v {
+			return n.name
+		}
+	}
+
+	return strconv.Itoa(v)
+}
+
+// parseName returns the value with the provided name. A number is accepted
+// in place of a name.
+func parseName(names []enumName, typ, s string) (int, error) {
+	for _, n := range names {
+		if n.name == s {
+			return n.value, nil
+		}
+	}
+
+	if v, err := strconv.Atoi(s); err == nil {
+		return v, nil
+	}
+
+	return 0, errors.Wrapf(ErrUnknownEnum, "cannot parse %q as %s", s, typ)
+}
+
+// marshalEnumJSON returns the JSON encoding of the provided value as a
+// number.
+func marshalEnumJSON(v int) ([]byte, error) {
+	return []byte(strconv.Itoa(v)), nil
+}
+
+// marshalEnumNameJSON returns the JSON encoding of the provided value as its
+// name, or as a number if it has no name.
+func marshalEnumNameJSON(names []enumName, v int) ([]byte, error) {
+	if n := nameOf(names, v); n != strconv.Itoa(v) {
+		return json.Marshal(n)
+	}
+
+	return marshalEnumJSON(v)
+}
+
+// unmarshalEnumJSON decodes a value encoded either as a number or a name.
+func unmarshalEnumJSON(names []enumName, typ string, b []byte) (int, error) {
+	b = bytes.TrimSpace(b)
+	if string(b) == "null" {
+		return 0, nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return 0, err
+		}
+		return parseName(names, typ, s)
+	}
+
+	var v int
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, errors.Wrapf(err, "cannot decode %s", typ)
+	}
+
+	return v, nil
+}
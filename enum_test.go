@@ -0,0 +1,280 @@
+package igdb
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseGameCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    GameCategory
+		wantErr error
+	}{
+		{"Name", "dlc_addon", DLCAddon, nil},
+		{"Zero value name", "main_game", MainGame, nil},
+		{"Number", "4", StandaloneExpansion, nil},
+		{"Unknown number", "42", GameCategory(42), nil},
+		{"Unknown name", "sequel", 0, ErrUnknownEnum},
+		{"Go name", "DLCAddon", 0, ErrUnknownEnum},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseGameCategory(test.s)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnum_Name(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"GameCategory", DLCAddon.Name(), "dlc_addon"},
+		{"GameStatus", StatusEarlyAccess.Name(), "early_access"},
+		{"RegionCategory", RegionNorthAmerica.Name(), "north_america"},
+		{"WebsiteCategory", WebsiteGooglePlus.Name(), "google_plus"},
+		{"AgeRatingEnum", AgeRatingE10.Name(), "e10"},
+		{"AgeRatingEnum CERO", AgeRatingCeroA.Name(), "cero_a"},
+		{"AgeRatingEnum USK", AgeRatingUSK16.Name(), "usk16"},
+		{"AgeRatingEnum ACB", AgeRatingAcbMA15.Name(), "acb_ma15"},
+		{"AgeRatingCategory", AgeRatingClassInd.Name(), "class_ind"},
+		{"ExternalGameCategory", ExternalGOG.Name(), "gog"},
+		{"DateCategory", DateYYYYQ1.Name(), "yyyyq1"},
+		{"Unknown value", RegionCategory(99).Name(), "99"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnum_Text(t *testing.T) {
+	b, err := RegionJapan.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "japan" {
+		t.Errorf("got: <%s>, want: <%v>", b, "japan")
+	}
+
+	var r RegionCategory
+	if err := r.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if r != RegionJapan {
+		t.Errorf("got: <%v>, want: <%v>", r, RegionJapan)
+	}
+
+	if err := r.UnmarshalText([]byte("atlantis")); errors.Cause(err) != ErrUnknownEnum {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnknownEnum)
+	}
+
+	m := map[WebsiteCategory]bool{WebsiteSteam: true}
+	b, err = json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"steam":true}` {
+		t.Errorf("got: <%s>, want: <%v>", b, `{"steam":true}`)
+	}
+}
+
+func TestEnum_JSON(t *testing.T) {
+	rd := ReleaseDate{Category: DateYYYY, Region: RegionEurope}
+
+	b, err := json.Marshal(rd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"category":2`; !json.Valid(b) || !strings.Contains(string(b), want) {
+		t.Errorf("got: <%s>, want: <%v>", b, want)
+	}
+
+	var got ReleaseDate
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Category != rd.Category || got.Region != rd.Region {
+		t.Errorf("got: <%v>, want: <%v>", got, rd)
+	}
+}
+
+func TestEnum_NameJSON(t *testing.T) {
+	type named struct {
+		Category DateCategoryName   `json:"category"`
+		Region   RegionCategoryName `json:"region"`
+	}
+
+	tests := []struct {
+		name string
+		in   named
+		want string
+	}{
+		{"Named values", named{DateCategoryName(DateYYYY), RegionCategoryName(RegionEurope)}, `{"category":"yyyy","region":"europe"}`},
+		{"Unknown value", named{DateCategoryName(99), RegionCategoryName(RegionEurope)}, `{"category":99,"region":"europe"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != test.want {
+				t.Errorf("got: <%s>, want: <%v>", b, test.want)
+			}
+
+			var got named
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != test.in {
+				t.Errorf("got: <%v>, want: <%v>", got, test.in)
+			}
+		})
+	}
+}
+
+func TestEnum_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    GameCategory
+		wantErr bool
+	}{
+		{"Number", `{"category": 1}`, DLCAddon, false},
+		{"Name", `{"category": "bundle"}`, Bundle, false},
+		{"Null", `{"category": null}`, MainGame, false},
+		{"Unknown name", `{"category": "sequel"}`, MainGame, true},
+		{"Invalid", `{"category": true}`, MainGame, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var g Game
+			err := json.Unmarshal([]byte(test.data), &g)
+			if (err != nil) != test.wantErr {
+				t.Errorf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+			if g.Category != test.want {
+				t.Errorf("got: <%v>, want: <%v>", g.Category, test.want)
+			}
+		})
+	}
+}
+
+func TestEnum_String(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"GameCategory", Remaster.String(), "Remaster"},
+		{"GameStatus", StatusDelisted.String(), "StatusDelisted"},
+		{"RegionCategory", RegionBrazil.String(), "RegionBrazil"},
+		{"ExternalGameCategory", ExternalItchIO.String(), "ExternalItchIO"},
+		{"ExternalGameCategory after gap", ExternalGamejolt.String(), "ExternalGamejolt"},
+		{"AgeRatingCategory", AgeRatingACB.String(), "AgeRatingACB"},
+		{"AgeRatingEnum", AgeRatingAcbRC.String(), "AgeRatingAcbRC"},
+		{"Unknown value", ExternalGameCategory(21).String(), "ExternalGameCategory(21)"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnum_IsKnown(t *testing.T) {
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"Known", Port.IsKnown(), true},
+		{"Zero value", MainGame.IsKnown(), true},
+		{"Unknown", GameCategory(99).IsKnown(), false},
+		{"Gap", ExternalGameCategory(2).IsKnown(), false},
+		{"Negative", RegionCategory(-1).IsKnown(), false},
+		{"After gap", ExternalXboxGamePassUltimateCloud.IsKnown(), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnum_Values(t *testing.T) {
+	vals := GameStatusValues()
+	want := []GameStatus{StatusReleased, StatusAlpha, StatusBeta, StatusEarlyAccess, StatusOffline, StatusCancelled, StatusRumored, StatusDelisted}
+	if len(vals) != len(want) {
+		t.Fatalf("got: <%v>, want: <%v>", vals, want)
+	}
+	for i := range want {
+		if vals[i] != want[i] {
+			t.Errorf("got: <%v>, want: <%v>", vals[i], want[i])
+		}
+	}
+
+	for _, v := range ExternalGameCategoryValues() {
+		if !v.IsKnown() {
+			t.Errorf("got: <%v>, want known value", v)
+		}
+	}
+}
+
+func TestEnumTypes(t *testing.T) {
+	types := EnumTypes()
+	if len(types) != len(enumRegistry) {
+		t.Errorf("got: <%v>, want: <%v>", len(types), len(enumRegistry))
+	}
+	for i := 1; i < len(types); i++ {
+		if types[i-1] >= types[i] {
+			t.Errorf("got: <%v>, want sorted types", types)
+		}
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		typ   string
+		first EnumValue
+		len   int
+	}{
+		{"RegionCategory", "RegionCategory", EnumValue{Value: 1, Name: "europe"}, 10},
+		{"GameCategory", "GameCategory", EnumValue{Value: 0, Name: "main_game"}, 15},
+		{"AgeRatingCategory", "AgeRatingCategory", EnumValue{Value: 1, Name: "esrb"}, 7},
+		{"AgeRatingEnum", "AgeRatingEnum", EnumValue{Value: 1, Name: "three"}, 39},
+		{"Unknown type", "Sequel", EnumValue{}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vals := EnumValues(test.typ)
+			if len(vals) != test.len {
+				t.Fatalf("got: <%v>, want: <%v>", len(vals), test.len)
+			}
+			if len(vals) > 0 && vals[0] != test.first {
+				t.Errorf("got: <%v>, want: <%v>", vals[0], test.first)
+			}
+		})
+	}
+}
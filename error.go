@@ -14,6 +14,8 @@ var (
 	ErrNegativeID = errors.New("ID cannot be negative")
 	// ErrEmptyIDs occurs when a List function is called without a populated int slice.
 	ErrEmptyIDs = errors.New("IDs argument empty")
+	// ErrEmptySlug occurs when a BySlug function, such as GameBySlug, is called with an empty slug.
+	ErrEmptySlug = errors.New("slug argument empty")
 	// ErrNoResults occurs when the IGDB returns an empty array, void of results.
 	ErrNoResults = errors.New("results are empty")
 	// errInvalidJSON occurs when encountering an unexpected end of JSON input.
@@ -15,11 +15,11 @@ import (
 type ExternalGame struct {
 	ID        int                  `json:"id"`
 	Category  ExternalGameCategory `json:"category"`
-	CreatedAt int                  `json:"created_at"`
+	CreatedAt UnixTime             `json:"created_at"`
 	Game      int                  `json:"game"`
 	Name      string               `json:"name"`
 	UID       string               `json:"uid"`
-	UpdatedAt int                  `json:"updated_at"`
+	UpdatedAt UnixTime             `json:"updated_at"`
 	Url       string               `json:"url"`
 	Year      int                  `json:"year"`
 }
@@ -46,6 +46,34 @@ const (
 	ExternalApple
 	ExternalTwitch
 	ExternalAndroid
+	_
+	_
+	_
+	_
+	ExternalAmazonASIN
+	_
+	ExternalAmazonLuna
+	ExternalAmazonADG
+	_
+	_
+	ExternalEpicGameStore
+	_
+	ExternalOculus
+	ExternalUtomik
+	ExternalItchIO
+	ExternalXboxMarketplace
+	ExternalKartridge
+	_
+	_
+	_
+	ExternalPlaystationStoreUS
+	ExternalFocusEntertainment
+)
+
+// Expected ExternalGameCategory enums from the IGDB that follow a long gap.
+const (
+	ExternalXboxGamePassUltimateCloud ExternalGameCategory = 54
+	ExternalGamejolt                  ExternalGameCategory = 55
 )
 
 // ExternalGameService handles all the API calls for the IGDB ExternalGame endpoint.
@@ -9,11 +9,21 @@ const (
 	_ExternalGameCategory_name_1 = "ExternalGOG"
 	_ExternalGameCategory_name_2 = "ExternalYoutubeExternalMicrosoft"
 	_ExternalGameCategory_name_3 = "ExternalAppleExternalTwitchExternalAndroid"
+	_ExternalGameCategory_name_4 = "ExternalAmazonASIN"
+	_ExternalGameCategory_name_5 = "ExternalAmazonLunaExternalAmazonADG"
+	_ExternalGameCategory_name_6 = "ExternalEpicGameStore"
+	_ExternalGameCategory_name_7 = "ExternalOculusExternalUtomikExternalItchIOExternalXboxMarketplaceExternalKartridge"
+	_ExternalGameCategory_name_8 = "ExternalPlaystationStoreUSExternalFocusEntertainment"
+	_ExternalGameCategory_name_9 = "ExternalXboxGamePassUltimateCloudExternalGamejolt"
 )
 
 var (
 	_ExternalGameCategory_index_2 = [...]uint8{0, 15, 32}
 	_ExternalGameCategory_index_3 = [...]uint8{0, 13, 27, 42}
+	_ExternalGameCategory_index_5 = [...]uint8{0, 18, 35}
+	_ExternalGameCategory_index_7 = [...]uint8{0, 14, 28, 42, 65, 82}
+	_ExternalGameCategory_index_8 = [...]uint8{0, 26, 52}
+	_ExternalGameCategory_index_9 = [...]uint8{0, 33, 49}
 )
 
 func (i ExternalGameCategory) String() string {
@@ -28,6 +38,22 @@ func (i ExternalGameCategory) String() string {
 	case 13 <= i && i <= 15:
 		i -= 13
 		return _ExternalGameCategory_name_3[_ExternalGameCategory_index_3[i]:_ExternalGameCategory_index_3[i+1]]
+	case i == 20:
+		return _ExternalGameCategory_name_4
+	case 22 <= i && i <= 23:
+		i -= 22
+		return _ExternalGameCategory_name_5[_ExternalGameCategory_index_5[i]:_ExternalGameCategory_index_5[i+1]]
+	case i == 26:
+		return _ExternalGameCategory_name_6
+	case 28 <= i && i <= 32:
+		i -= 28
+		return _ExternalGameCategory_name_7[_ExternalGameCategory_index_7[i]:_ExternalGameCategory_index_7[i+1]]
+	case 36 <= i && i <= 37:
+		i -= 36
+		return _ExternalGameCategory_name_8[_ExternalGameCategory_index_8[i]:_ExternalGameCategory_index_8[i+1]]
+	case 54 <= i && i <= 55:
+		i -= 54
+		return _ExternalGameCategory_name_9[_ExternalGameCategory_index_9[i]:_ExternalGameCategory_index_9[i+1]]
 	default:
 		return "ExternalGameCategory(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
@@ -14,17 +14,17 @@ type Feed struct {
 	ID             int          `json:"id"`
 	Category       FeedCategory `json:"category"`
 	Content        string       `json:"content"`
-	CreatedAt      int          `json:"created_at"`
+	CreatedAt      UnixTime     `json:"created_at"`
 	FeedLikesCount int          `json:"feed_likes_count"`
 	FeedVideo      int          `json:"feed_video"`
 	Games          []int        `json:"games"`
 	Meta           string       `json:"meta"`
-	PublishedAt    int          `json:"published_at"`
+	PublishedAt    UnixTime     `json:"published_at"`
 	Pulse          int          `json:"pulse"`
 	Slug           string       `json:"slug"`
 	Title          string       `json:"title"`
 	UID            string       `json:"uid"`
-	UpdatedAt      int          `json:"updated_at"`
+	UpdatedAt      UnixTime     `json:"updated_at"`
 	URL            string       `json:"url"`
 	User           int          `json:"user"`
 }
@@ -67,6 +67,19 @@ func (fs *FeedService) Get(id int, opts ...Option) (*Feed, error) {
 	return feed[0], nil
 }
 
+// FeedBySlug returns a single Feed identified by the provided slug using the
+// provided FeedReader, such as the Feeds field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Feeds, an error is returned.
+func FeedBySlug(r FeedReader, slug string, opts ...Option) (*Feed, error) {
+	feed, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Feed with slug %s", slug)
+	}
+
+	return feed[0], nil
+}
+
 // List returns a list of Feeds identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Feed is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestFeedService_Get(t *testing.T) {
 	}
 }
 
+func TestFeedBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testFeedGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Feed, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name     string
+		file     string
+		slug     string
+		opts     []Option
+		wantFeed *Feed
+		wantErr  error
+	}{
+		{"Valid response", testFeedGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			feed, err := FeedBySlug(c.Feeds, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(feed, test.wantFeed) {
+				t.Errorf("got: <%v>, \nwant: <%v>", feed, test.wantFeed)
+			}
+		})
+	}
+}
+
 func TestFeedService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testFeedList)
 	if err != nil {
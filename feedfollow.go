@@ -13,10 +13,10 @@ import (
 // For more information visit: https://api-docs.igdb.com/#feed-follow
 type FeedFollow struct {
 	ID          int          `json:"id"`
-	CreatedAt   int          `json:"created_at"`
+	CreatedAt   UnixTime     `json:"created_at"`
 	Feed        FeedCategory `json:"feed"`
-	PublishedAt int          `json:"published_at"`
-	UpdatedAt   int          `json:"updated_at"`
+	PublishedAt UnixTime     `json:"published_at"`
+	UpdatedAt   UnixTime     `json:"updated_at"`
 	User        int          `json:"user"`
 }
 
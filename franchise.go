@@ -37,6 +37,20 @@ func (fs *FranchiseService) Get(id int, opts ...Option) (*Franchise, error) {
 	return fr[0], nil
 }
 
+// FranchiseBySlug returns a single Franchise identified by the provided slug
+// using the provided FranchiseReader, such as the Franchises field of a
+// Client. Provide the SetFields functional option if you need to specify which
+// fields to retrieve. If the slug does not match any Franchises, an error is
+// returned.
+func FranchiseBySlug(r FranchiseReader, slug string, opts ...Option) (*Franchise, error) {
+	fr, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Franchise with slug %s", slug)
+	}
+
+	return fr[0], nil
+}
+
 // List returns a list of Franchises identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Franchise is ignored. If none of the IDs
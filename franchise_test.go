@@ -57,6 +57,49 @@ func TestFranchiseService_Get(t *testing.T) {
 	}
 }
 
+func TestFranchiseBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testFranchiseGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Franchise, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name          string
+		file          string
+		slug          string
+		opts          []Option
+		wantFranchise *Franchise
+		wantErr       error
+	}{
+		{"Valid response", testFranchiseGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fr, err := FranchiseBySlug(c.Franchises, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fr, test.wantFranchise) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fr, test.wantFranchise)
+			}
+		})
+	}
+}
+
 func TestFranchiseService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testFranchiseList)
 	if err != nil {
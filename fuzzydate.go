@@ -0,0 +1,275 @@
+package igdb
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DatePrecision specifies how precisely a FuzzyDate is known.
+type DatePrecision int
+
+// Available date precisions, from least to most precise.
+const (
+	PrecisionTBD DatePrecision = iota
+	PrecisionYear
+	PrecisionQuarter
+	PrecisionMonth
+	PrecisionDay
+)
+
+// String returns the name of the DatePrecision.
+func (p DatePrecision) String() string {
+	switch p {
+	case PrecisionTBD:
+		return "tbd"
+	case PrecisionYear:
+		return "year"
+	case PrecisionQuarter:
+		return "quarter"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	}
+
+	return fmt.Sprintf("DatePrecision(%d)", int(p))
+}
+
+// FuzzyDate is a date known only to the precision given by its DateCategory,
+// such as a release date announced for a year or quarter. A FuzzyDate
+// represents the whole period starting at Start and lasting until End.
+type FuzzyDate struct {
+	start     time.Time
+	precision DatePrecision
+}
+
+// NewFuzzyDate returns the FuzzyDate for the provided date and category. The
+// date is truncated to the start of the period described by the category.
+// A date of 0 is treated as TBD.
+func NewFuzzyDate(date UnixTime, cat DateCategory) FuzzyDate {
+	if date.IsZero() {
+		return FuzzyDate{}
+	}
+
+	return newFuzzyDate(date.Time(), cat)
+}
+
+// newFuzzyDate returns the FuzzyDate for the provided time and category.
+func newFuzzyDate(t time.Time, cat DateCategory) FuzzyDate {
+	y, m, d := t.Date()
+
+	switch cat {
+	case DateYYYYMMMMDD:
+		return FuzzyDate{time.Date(y, m, d, 0, 0, 0, 0, time.UTC), PrecisionDay}
+	case DateYYYYMMMM:
+		return FuzzyDate{time.Date(y, m, 1, 0, 0, 0, 0, time.UTC), PrecisionMonth}
+	case DateYYYY:
+		return FuzzyDate{time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionYear}
+	case DateYYYYQ1, DateYYYYQ2, DateYYYYQ3, DateYYYYQ4:
+		q := int(cat - DateYYYYQ1)
+		return FuzzyDate{time.Date(y, time.Month(q*3+1), 1, 0, 0, 0, 0, time.UTC), PrecisionQuarter}
+	}
+
+	return FuzzyDate{}
+}
+
+// Precision returns how precisely the FuzzyDate is known.
+func (f FuzzyDate) Precision() DatePrecision {
+	return f.precision
+}
+
+// IsTBD returns true if the FuzzyDate is not known at all.
+func (f FuzzyDate) IsTBD() bool {
+	return f.precision == PrecisionTBD
+}
+
+// Start returns the first instant of the FuzzyDate's period. The zero
+// time.Time is returned if the FuzzyDate is TBD.
+func (f FuzzyDate) Start() time.Time {
+	return f.start
+}
+
+// End returns the first instant after the FuzzyDate's period. The zero
+// time.Time is returned if the FuzzyDate is TBD.
+func (f FuzzyDate) End() time.Time {
+	switch f.precision {
+	case PrecisionYear:
+		return f.start.AddDate(1, 0, 0)
+	case PrecisionQuarter:
+		return f.start.AddDate(0, 3, 0)
+	case PrecisionMonth:
+		return f.start.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return f.start.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}
+}
+
+// Year returns the year of the FuzzyDate, or 0 if it is TBD.
+func (f FuzzyDate) Year() int {
+	if f.IsTBD() {
+		return 0
+	}
+
+	return f.start.Year()
+}
+
+// Quarter returns the quarter, from 1 to 4, of the FuzzyDate. Zero is
+// returned if the FuzzyDate is only known to the year or is TBD.
+func (f FuzzyDate) Quarter() int {
+	if f.precision < PrecisionQuarter {
+		return 0
+	}
+
+	return (int(f.start.Month())-1)/3 + 1
+}
+
+// Month returns the month of the FuzzyDate. Zero is returned if the
+// FuzzyDate is only known to the quarter or less.
+func (f FuzzyDate) Month() time.Month {
+	if f.precision < PrecisionMonth {
+		return 0
+	}
+
+	return f.start.Month()
+}
+
+// Day returns the day of the month of the FuzzyDate. Zero is returned if
+// the FuzzyDate is only known to the month or less.
+func (f FuzzyDate) Day() int {
+	if f.precision < PrecisionDay {
+		return 0
+	}
+
+	return f.start.Day()
+}
+
+// Contains returns true if the provided time falls within the FuzzyDate's
+// period. A TBD FuzzyDate contains no times.
+func (f FuzzyDate) Contains(t time.Time) bool {
+	if f.IsTBD() {
+		return false
+	}
+
+	return !t.Before(f.start) && t.Before(f.End())
+}
+
+// Compare returns -1, 0, or 1 if the FuzzyDate sorts before, equal to, or
+// after the provided FuzzyDate. FuzzyDates are ordered by the start of their
+// period, then by the end of their period so that a more precise date sorts
+// before a less precise date starting at the same time (e.g. 2018-Jan-01,
+// 2018-Jan, 2018-Q1, 2018). TBD sorts after every known date.
+func (f FuzzyDate) Compare(o FuzzyDate) int {
+	switch {
+	case f.IsTBD() && o.IsTBD():
+		return 0
+	case f.IsTBD():
+		return 1
+	case o.IsTBD():
+		return -1
+	case f.start.Before(o.start):
+		return -1
+	case f.start.After(o.start):
+		return 1
+	case f.precision > o.precision:
+		return -1
+	case f.precision < o.precision:
+		return 1
+	}
+
+	return 0
+}
+
+// Before returns true if the FuzzyDate's whole period is before the provided
+// FuzzyDate's period. Overlapping periods, such as 2018-Q1 and 2018-Feb, are
+// neither before nor after each other. TBD is never before or after a date.
+func (f FuzzyDate) Before(o FuzzyDate) bool {
+	if f.IsTBD() || o.IsTBD() {
+		return false
+	}
+
+	return !f.End().After(o.start)
+}
+
+// After returns true if the FuzzyDate's whole period is after the provided
+// FuzzyDate's period.
+func (f FuzzyDate) After(o FuzzyDate) bool {
+	return o.Before(f)
+}
+
+// Equal returns true if both FuzzyDates describe the same period with the
+// same precision.
+func (f FuzzyDate) Equal(o FuzzyDate) bool {
+	return f.Compare(o) == 0
+}
+
+// String returns the FuzzyDate formatted the same as the human readable
+// dates provided by the IGDB (e.g. 2018-Sep-28, 2018-Sep, 2018-Q3, 2018,
+// or TBD).
+func (f FuzzyDate) String() string {
+	switch f.precision {
+	case PrecisionYear:
+		return f.start.Format("2006")
+	case PrecisionQuarter:
+		return fmt.Sprintf("%d-Q%d", f.start.Year(), f.Quarter())
+	case PrecisionMonth:
+		return f.start.Format("2006-Jan")
+	case PrecisionDay:
+		return f.start.Format("2006-Jan-02")
+	}
+
+	return "TBD"
+}
+
+// SortFuzzyDates sorts the provided FuzzyDates in the order given by Compare.
+func SortFuzzyDates(dates []FuzzyDate) {
+	sort.SliceStable(dates, func(i, j int) bool {
+		return dates[i].Compare(dates[j]) < 0
+	})
+}
+
+// FuzzyDate returns the ReleaseDate as a FuzzyDate. If the ReleaseDate has
+// no date, its year and month are used instead.
+func (r *ReleaseDate) FuzzyDate() FuzzyDate {
+	return fuzzyDateOf(r.Date, r.Category, r.Y, r.M)
+}
+
+// FuzzyDate returns the PlatformVersionReleaseDate as a FuzzyDate. If the
+// PlatformVersionReleaseDate has no date, its year and month are used
+// instead.
+func (p *PlatformVersionReleaseDate) FuzzyDate() FuzzyDate {
+	return fuzzyDateOf(p.Date, p.Category, p.Y, p.M)
+}
+
+// StartFuzzyDate returns the date the Company was founded as a FuzzyDate.
+func (c *Company) StartFuzzyDate() FuzzyDate {
+	return NewFuzzyDate(c.StartDate, c.StartDateCategory)
+}
+
+// ChangeFuzzyDate returns the date the Company changed as a FuzzyDate.
+func (c *Company) ChangeFuzzyDate() FuzzyDate {
+	return NewFuzzyDate(c.ChangeDate, c.ChangeDateCategory)
+}
+
+// fuzzyDateOf returns the FuzzyDate for the provided date and category,
+// falling back to the provided year and month when the date is missing.
+// The precision of the fallback is limited to what the year and month tell.
+func fuzzyDateOf(date UnixTime, cat DateCategory, y, m int) FuzzyDate {
+	if !date.IsZero() || y == 0 || cat == DateTBD {
+		return NewFuzzyDate(date, cat)
+	}
+
+	if cat == DateYYYYMMMMDD {
+		cat = DateYYYYMMMM
+	}
+	if m < 1 || m > 12 {
+		m = 1
+		if cat == DateYYYYMMMM {
+			cat = DateYYYY
+		}
+	}
+
+	return newFuzzyDate(time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.UTC), cat)
+}
@@ -0,0 +1,191 @@
+package igdb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// 2018-09-28 00:00:00 UTC
+const testFuzzyUnix UnixTime = 1538092800
+
+func TestNewFuzzyDate(t *testing.T) {
+	tests := []struct {
+		name          string
+		date          UnixTime
+		cat           DateCategory
+		wantPrecision DatePrecision
+		wantString    string
+		wantStart     time.Time
+		wantEnd       time.Time
+	}{
+		{"Day", testFuzzyUnix + 3600, DateYYYYMMMMDD, PrecisionDay, "2018-Sep-28", time.Date(2018, 9, 28, 0, 0, 0, 0, time.UTC), time.Date(2018, 9, 29, 0, 0, 0, 0, time.UTC)},
+		{"Month", testFuzzyUnix, DateYYYYMMMM, PrecisionMonth, "2018-Sep", time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"Year", testFuzzyUnix, DateYYYY, PrecisionYear, "2018", time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Quarter", testFuzzyUnix, DateYYYYQ4, PrecisionQuarter, "2018-Q4", time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"TBD", testFuzzyUnix, DateTBD, PrecisionTBD, "TBD", time.Time{}, time.Time{}},
+		{"Missing date", 0, DateYYYYMMMMDD, PrecisionTBD, "TBD", time.Time{}, time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := NewFuzzyDate(test.date, test.cat)
+
+			if f.Precision() != test.wantPrecision {
+				t.Errorf("got: <%v>, want: <%v>", f.Precision(), test.wantPrecision)
+			}
+			if f.String() != test.wantString {
+				t.Errorf("got: <%v>, want: <%v>", f.String(), test.wantString)
+			}
+			if !f.Start().Equal(test.wantStart) {
+				t.Errorf("got: <%v>, want: <%v>", f.Start(), test.wantStart)
+			}
+			if !f.End().Equal(test.wantEnd) {
+				t.Errorf("got: <%v>, want: <%v>", f.End(), test.wantEnd)
+			}
+		})
+	}
+}
+
+func TestFuzzyDate_Parts(t *testing.T) {
+	tests := []struct {
+		name        string
+		f           FuzzyDate
+		wantTBD     bool
+		wantYear    int
+		wantQuarter int
+		wantMonth   time.Month
+		wantDay     int
+	}{
+		{"Day", NewFuzzyDate(testFuzzyUnix, DateYYYYMMMMDD), false, 2018, 3, time.September, 28},
+		{"Month", NewFuzzyDate(testFuzzyUnix, DateYYYYMMMM), false, 2018, 3, time.September, 0},
+		{"Quarter", NewFuzzyDate(testFuzzyUnix, DateYYYYQ2), false, 2018, 2, 0, 0},
+		{"Year", NewFuzzyDate(testFuzzyUnix, DateYYYY), false, 2018, 0, 0, 0},
+		{"TBD", NewFuzzyDate(testFuzzyUnix, DateTBD), true, 0, 0, 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := []interface{}{test.f.IsTBD(), test.f.Year(), test.f.Quarter(), test.f.Month(), test.f.Day()}
+			want := []interface{}{test.wantTBD, test.wantYear, test.wantQuarter, test.wantMonth, test.wantDay}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got: <%v>, want: <%v>", got, want)
+			}
+		})
+	}
+}
+
+func TestFuzzyDate_Compare(t *testing.T) {
+	day := NewFuzzyDate(testFuzzyUnix, DateYYYYMMMMDD)
+	month := NewFuzzyDate(testFuzzyUnix, DateYYYYMMMM)
+	quarter := NewFuzzyDate(testFuzzyUnix, DateYYYYQ3)
+	year := NewFuzzyDate(testFuzzyUnix, DateYYYY)
+	next := NewFuzzyDate(testFuzzyUnix+86400*7, DateYYYYMMMMDD)
+	tbd := NewFuzzyDate(0, DateTBD)
+
+	tests := []struct {
+		name        string
+		a, b        FuzzyDate
+		wantCompare int
+		wantBefore  bool
+		wantAfter   bool
+	}{
+		{"Same day", day, day, 0, false, false},
+		{"Day before next week", day, next, -1, true, false},
+		{"Next week after day", next, day, 1, false, true},
+		{"Day within month", day, month, 1, false, false},
+		{"Month within quarter", month, quarter, 1, false, false},
+		{"Year before later date", year, NewFuzzyDate(testFuzzyUnix+86400*365, DateYYYYMMMMDD), -1, true, false},
+		{"Known before TBD", year, tbd, -1, false, false},
+		{"TBD after known", tbd, day, 1, false, false},
+		{"TBD equals TBD", tbd, NewFuzzyDate(testFuzzyUnix, DateTBD), 0, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.a.Compare(test.b); got != test.wantCompare {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantCompare)
+			}
+			if got := test.a.Before(test.b); got != test.wantBefore {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantBefore)
+			}
+			if got := test.a.After(test.b); got != test.wantAfter {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantAfter)
+			}
+		})
+	}
+}
+
+func TestSortFuzzyDates(t *testing.T) {
+	dates := []FuzzyDate{
+		NewFuzzyDate(0, DateTBD),
+		NewFuzzyDate(testFuzzyUnix, DateYYYY),
+		NewFuzzyDate(testFuzzyUnix, DateYYYYQ1),
+		NewFuzzyDate(testFuzzyUnix, DateYYYYMMMMDD),
+		NewFuzzyDate(1514764800, DateYYYYMMMMDD),
+	}
+	SortFuzzyDates(dates)
+
+	var got []string
+	for _, d := range dates {
+		got = append(got, d.String())
+	}
+
+	want := []string{"2018-Jan-01", "2018-Q1", "2018", "2018-Sep-28", "TBD"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestReleaseDate_FuzzyDate(t *testing.T) {
+	tests := []struct {
+		name string
+		rd   ReleaseDate
+		want string
+	}{
+		{"Date", ReleaseDate{Date: testFuzzyUnix, Category: DateYYYYMMMMDD, Y: 2018, M: 9}, "2018-Sep-28"},
+		{"Year and month only", ReleaseDate{Category: DateYYYYMMMMDD, Y: 2018, M: 9}, "2018-Sep"},
+		{"Year only", ReleaseDate{Category: DateYYYYMMMM, Y: 2018}, "2018"},
+		{"Quarter from year", ReleaseDate{Category: DateYYYYQ2, Y: 2018}, "2018-Q2"},
+		{"TBD", ReleaseDate{Category: DateTBD, Y: 2018}, "TBD"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.rd.FuzzyDate().String(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+
+			pv := PlatformVersionReleaseDate{Date: test.rd.Date, Category: test.rd.Category, Y: test.rd.Y, M: test.rd.M}
+			if got := pv.FuzzyDate().String(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompany_FuzzyDates(t *testing.T) {
+	c := Company{StartDate: testFuzzyUnix, StartDateCategory: DateYYYY, ChangeDate: testFuzzyUnix, ChangeDateCategory: DateYYYYMMMM}
+
+	if got := c.StartFuzzyDate().String(); got != "2018" {
+		t.Errorf("got: <%v>, want: <%v>", got, "2018")
+	}
+	if got := c.ChangeFuzzyDate().String(); got != "2018-Sep" {
+		t.Errorf("got: <%v>, want: <%v>", got, "2018-Sep")
+	}
+}
+
+func TestFuzzyDate_Human(t *testing.T) {
+	var dates []*ReleaseDate
+	ts, c, err := testServerFile(200, "test_data/releasedate_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	if err := c.get(EndpointReleaseDate, &dates); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range dates {
+		if got := d.FuzzyDate().String(); got != d.Human {
+			t.Errorf("got: <%v>, want: <%v>", got, d.Human)
+		}
+	}
+}
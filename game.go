@@ -26,7 +26,7 @@ type Game struct {
 	DLCS                  []int        `json:"dlcs"`
 	Expansions            []int        `json:"expansions"`
 	ExternalGames         []int        `json:"external_games"`
-	FirstReleaseDate      int          `json:"first_release_date"`
+	FirstReleaseDate      UnixTime     `json:"first_release_date"`
 	Follows               int          `json:"follows"`
 	Franchise             int          `json:"franchise"`
 	Franchises            []int        `json:"franchises"`
@@ -74,6 +74,16 @@ const (
 	Expansion
 	Bundle
 	StandaloneExpansion
+	Mod
+	Episode
+	Season
+	Remake
+	Remaster
+	ExpandedGame
+	Port
+	Fork
+	Pack
+	Update
 )
 
 // GameStatus specifies the release status of a specific game.
@@ -88,6 +98,8 @@ const (
 	StatusEarlyAccess
 	StatusOffline
 	StatusCancelled
+	StatusRumored
+	StatusDelisted
 )
 
 // GameService handles all the API
@@ -113,6 +125,19 @@ func (gs *GameService) Get(id int, opts ...Option) (*Game, error) {
 	return g[0], nil
 }
 
+// GameBySlug returns a single Game identified by the provided slug using the
+// provided GameReader, such as the Games field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Games, an error is returned.
+func GameBySlug(r GameReader, slug string, opts ...Option) (*Game, error) {
+	g, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Game with slug %s", slug)
+	}
+
+	return g[0], nil
+}
+
 // List returns a list of Games identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Game is ignored. If none of the IDs
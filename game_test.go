@@ -59,6 +59,71 @@ func TestGameService_Get(t *testing.T) {
 	}
 }
 
+func TestGameBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name     string
+		file     string
+		slug     string
+		opts     []Option
+		wantGame *Game
+		wantErr  error
+	}{
+		{"Valid response", testGameGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, err := GameBySlug(c.Games, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGame) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGame)
+			}
+		})
+	}
+}
+
+func TestGameBySlug_Request(t *testing.T) {
+	var body string
+	ts, c, _ := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `[{"id": 1942, "slug": "the-witcher-3-wild-hunt"}]`)
+	})
+	defer ts.Close()
+
+	g, err := GameBySlug(c.Games, "the-witcher-3-wild-hunt", SetFields("slug"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.ID != 1942 {
+		t.Errorf("got: <%v>, want: <%v>", g.ID, 1942)
+	}
+
+	if want := "fields slug; where slug = \"the-witcher-3-wild-hunt\"; "; body != want {
+		t.Errorf("got: <%v>, want: <%v>", body, want)
+	}
+}
+
 func TestGameService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameList)
 	if err != nil {
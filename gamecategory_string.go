@@ -4,9 +4,9 @@ package igdb
 
 import "strconv"
 
-const _GameCategory_name = "MainGameDLCAddonExpansionBundleStandaloneExpansion"
+const _GameCategory_name = "MainGameDLCAddonExpansionBundleStandaloneExpansionModEpisodeSeasonRemakeRemasterExpandedGamePortForkPackUpdate"
 
-var _GameCategory_index = [...]uint8{0, 8, 16, 25, 31, 50}
+var _GameCategory_index = [...]uint8{0, 8, 16, 25, 31, 50, 53, 60, 66, 72, 80, 92, 96, 100, 104, 110}
 
 func (i GameCategory) String() string {
 	if i < 0 || i >= GameCategory(len(_GameCategory_index)-1) {
@@ -17,18 +17,18 @@ func (i GameCategory) String() string {
 
 const (
 	_GameStatus_name_0 = "StatusReleased"
-	_GameStatus_name_1 = "StatusAlphaStatusBetaStatusEarlyAccessStatusOfflineStatusCancelled"
+	_GameStatus_name_1 = "StatusAlphaStatusBetaStatusEarlyAccessStatusOfflineStatusCancelledStatusRumoredStatusDelisted"
 )
 
 var (
-	_GameStatus_index_1 = [...]uint8{0, 11, 21, 38, 51, 66}
+	_GameStatus_index_1 = [...]uint8{0, 11, 21, 38, 51, 66, 79, 93}
 )
 
 func (i GameStatus) String() string {
 	switch {
 	case i == 0:
 		return _GameStatus_name_0
-	case 2 <= i && i <= 6:
+	case 2 <= i && i <= 8:
 		i -= 2
 		return _GameStatus_name_1[_GameStatus_index_1[i]:_GameStatus_index_1[i+1]]
 	default:
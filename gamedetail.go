@@ -0,0 +1,554 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// detailConcurrency is the largest number of sections LoadGameDetail
+// retrieves at the same time.
+const detailConcurrency = 4
+
+// GameSection is a part of a GameDetail loaded from a separate endpoint.
+type GameSection string
+
+// Available sections of a GameDetail
+const (
+	SectionCover             GameSection = "cover"
+	SectionScreenshots       GameSection = "screenshots"
+	SectionArtworks          GameSection = "artworks"
+	SectionGenres            GameSection = "genres"
+	SectionThemes            GameSection = "themes"
+	SectionPlatforms         GameSection = "platforms"
+	SectionInvolvedCompanies GameSection = "involved_companies"
+	SectionCompanies         GameSection = "companies"
+	SectionReleaseDates      GameSection = "release_dates"
+	SectionAgeRatings        GameSection = "age_ratings"
+	SectionWebsites          GameSection = "websites"
+	SectionVideos            GameSection = "videos"
+	SectionMultiplayerModes  GameSection = "multiplayer_modes"
+	SectionTimeToBeat        GameSection = "time_to_beat"
+)
+
+// GameSections lists every GameSection.
+var GameSections = []GameSection{
+	SectionCover,
+	SectionScreenshots,
+	SectionArtworks,
+	SectionGenres,
+	SectionThemes,
+	SectionPlatforms,
+	SectionInvolvedCompanies,
+	SectionCompanies,
+	SectionReleaseDates,
+	SectionAgeRatings,
+	SectionWebsites,
+	SectionVideos,
+	SectionMultiplayerModes,
+	SectionTimeToBeat,
+}
+
+// GameDetail contains a Game along with the entities it references, such
+// as for a page describing the Game. Each section of a GameDetail is only
+// loaded if it was requested, and is left empty if the Game does not
+// reference any entities of that section.
+type GameDetail struct {
+	Game              *Game
+	Cover             *Cover
+	Screenshots       []*Screenshot
+	Artworks          []*Artwork
+	Genres            []*Genre
+	Themes            []*Theme
+	Platforms         []*Platform
+	InvolvedCompanies []*InvolvedCompany
+	// Companies holds the Companies of the InvolvedCompanies.
+	Companies        []*Company
+	ReleaseDates     []*ReleaseDate
+	AgeRatings       []*AgeRating
+	Websites         []*Website
+	Videos           []*GameVideo
+	MultiplayerModes []*MultiplayerMode
+	TimeToBeat       *TimeToBeat
+
+	// Errors holds the error of each section that failed to load.
+	Errors map[GameSection]error
+}
+
+// Err returns the error of the first section that failed to load, in the
+// order of GameSections, or nil if every section loaded.
+func (d *GameDetail) Err() error {
+	for _, sec := range GameSections {
+		if err, ok := d.Errors[sec]; ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Company returns the Company with the provided ID among the Companies of
+// the GameDetail, or nil if it is not present.
+func (d *GameDetail) Company(id int) *Company {
+	for _, c := range d.Companies {
+		if c.ID == id {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// LoadGameDetail returns the GameDetail of the Game identified by the
+// provided IGDB ID with the provided sections loaded. If no sections are
+// provided, every section is loaded. Loading SectionCompanies also loads the
+// InvolvedCompanies the Companies are found through.
+//
+// The Game and its sections are retrieved with a single request to the games
+// endpoint that expands the fields referencing each section (e.g. cover.*).
+// A section the response does not hold in full is then retrieved separately
+// through the Reader fields of the provided Client, as is every section if
+// the expanded request fails, with at most four sections in flight at a
+// time. If the Game cannot be retrieved, an error is returned. A section that
+// fails to load does not fail the others; its error is stored in the
+// GameDetail's Errors instead.
+func LoadGameDetail(c *Client, id int, include ...GameSection) (*GameDetail, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	if len(include) == 0 {
+		include = GameSections
+	}
+	want := make(map[GameSection]bool)
+	for _, sec := range include {
+		want[sec] = true
+	}
+
+	d, retry, err := loadExpandedDetail(c, id, want)
+	if errors.Cause(err) == ErrNoResults {
+		return nil, errors.Wrapf(err, "cannot get detail of Game with ID %v", id)
+	}
+	if err != nil {
+		g, err := c.Games.Get(id, SetFields("*"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get detail of Game with ID %v", id)
+		}
+		d, retry = &GameDetail{Game: g, Errors: make(map[GameSection]error)}, want
+	}
+
+	loadDetailSections(c, d, retry)
+
+	return d, nil
+}
+
+// detailFields holds the expanded fields retrieving each GameSection along
+// with its Game.
+var detailFields = map[GameSection][]string{
+	SectionCover:             {"cover.*"},
+	SectionScreenshots:       {"screenshots.*"},
+	SectionArtworks:          {"artworks.*"},
+	SectionGenres:            {"genres.*"},
+	SectionThemes:            {"themes.*"},
+	SectionPlatforms:         {"platforms.*"},
+	SectionInvolvedCompanies: {"involved_companies.*"},
+	SectionCompanies:         {"involved_companies.*", "involved_companies.company.*"},
+	SectionReleaseDates:      {"release_dates.*"},
+	SectionAgeRatings:        {"age_ratings.*"},
+	SectionWebsites:          {"websites.*"},
+	SectionVideos:            {"videos.*"},
+	SectionMultiplayerModes:  {"multiplayer_modes.*"},
+	SectionTimeToBeat:        {"time_to_beat.*"},
+}
+
+// loadExpandedDetail retrieves the Game with the provided ID along with the
+// wanted sections in a single request. The sections that could not be
+// decoded from the response are returned to be loaded separately.
+func loadExpandedDetail(c *Client, id int, want map[GameSection]bool) (*GameDetail, map[GameSection]bool, error) {
+	fields := []string{"*"}
+	seen := make(map[string]bool)
+	for _, sec := range GameSections {
+		if !want[sec] {
+			continue
+		}
+		for _, f := range detailFields[sec] {
+			if !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	var raw []json.RawMessage
+	err := c.get(EndpointGame, &raw, SetFields(fields...), SetFilter("id", OpEquals, strconv.Itoa(id)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := []string{"cover", "screenshots", "artworks", "genres", "themes", "platforms", "involved_companies",
+		"release_dates", "age_ratings", "websites", "videos", "multiplayer_modes", "time_to_beat"}
+	game, exp, err := splitExpanded(raw[0], keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := &GameDetail{Game: &Game{}, Errors: make(map[GameSection]error)}
+	if err := json.Unmarshal(game, d.Game); err != nil {
+		return nil, nil, errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	decoders := map[GameSection]func() error{
+		SectionCover:             func() error { return decodeExpanded(exp["cover"], &d.Cover) },
+		SectionScreenshots:       func() error { return decodeExpanded(exp["screenshots"], &d.Screenshots) },
+		SectionArtworks:          func() error { return decodeExpanded(exp["artworks"], &d.Artworks) },
+		SectionGenres:            func() error { return decodeExpanded(exp["genres"], &d.Genres) },
+		SectionThemes:            func() error { return decodeExpanded(exp["themes"], &d.Themes) },
+		SectionPlatforms:         func() error { return decodeExpanded(exp["platforms"], &d.Platforms) },
+		SectionReleaseDates:      func() error { return decodeExpanded(exp["release_dates"], &d.ReleaseDates) },
+		SectionAgeRatings:        func() error { return decodeExpanded(exp["age_ratings"], &d.AgeRatings) },
+		SectionWebsites:          func() error { return decodeExpanded(exp["websites"], &d.Websites) },
+		SectionVideos:            func() error { return decodeExpanded(exp["videos"], &d.Videos) },
+		SectionMultiplayerModes:  func() error { return decodeExpanded(exp["multiplayer_modes"], &d.MultiplayerModes) },
+		SectionTimeToBeat:        func() error { return decodeExpanded(exp["time_to_beat"], &d.TimeToBeat) },
+		SectionInvolvedCompanies: func() error { return decodeInvolvedCompanies(exp["involved_companies"], d, want[SectionCompanies]) },
+	}
+
+	retry := make(map[GameSection]bool)
+	for _, sec := range GameSections {
+		decode, ok := decoders[sec]
+		need := want[sec] || sec == SectionInvolvedCompanies && want[SectionCompanies]
+		if !ok || !need {
+			continue
+		}
+		if err := decode(); err == nil {
+			continue
+		}
+
+		retry[sec] = want[sec]
+		if sec == SectionInvolvedCompanies {
+			d.InvolvedCompanies, d.Companies = nil, nil
+			retry[SectionCompanies] = want[SectionCompanies]
+		}
+	}
+
+	return d, retry, nil
+}
+
+// decodeExpanded decodes the provided expanded entities into the value
+// pointed to by v. Entities that were not expanded fail to decode, leaving
+// the value unchanged.
+func decodeExpanded(b json.RawMessage, v interface{}) error {
+	if b == nil {
+		return nil
+	}
+
+	dst := reflect.ValueOf(v).Elem()
+	tmp := reflect.New(dst.Type())
+	if err := json.Unmarshal(b, tmp.Interface()); err != nil {
+		return err
+	}
+	dst.Set(tmp.Elem())
+
+	return nil
+}
+
+// decodeInvolvedCompanies decodes the provided expanded InvolvedCompanies
+// into the GameDetail along with, if requested, their expanded Companies.
+func decodeInvolvedCompanies(b json.RawMessage, d *GameDetail, companies bool) error {
+	if b == nil {
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		obj, exp, err := splitExpanded(item, []string{"company"})
+		if err != nil {
+			return err
+		}
+
+		ic := &InvolvedCompany{}
+		if err := json.Unmarshal(obj, ic); err != nil {
+			return err
+		}
+		d.InvolvedCompanies = append(d.InvolvedCompanies, ic)
+
+		if !companies || exp["company"] == nil {
+			continue
+		}
+		co := &Company{}
+		if err := json.Unmarshal(exp["company"], co); err != nil {
+			return err
+		}
+		if d.Company(co.ID) == nil {
+			d.Companies = append(d.Companies, co)
+		}
+	}
+
+	return nil
+}
+
+// splitExpanded returns the provided JSON object with the values of the
+// provided keys replaced by the IDs of the entities they expand, along with
+// the replaced values. Values that are not expanded are left unchanged.
+func splitExpanded(b json.RawMessage, keys []string) (json.RawMessage, map[string]json.RawMessage, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, nil, errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	exp := make(map[string]json.RawMessage)
+	for _, k := range keys {
+		v, ok := obj[k]
+		if !ok {
+			continue
+		}
+
+		ids, err := expandedIDs(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp[k], obj[k] = v, ids
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot encode expanded entity")
+	}
+
+	return b, exp, nil
+}
+
+// expandedIDs returns the ID of the provided expanded entity, or the IDs of
+// the provided array of expanded entities. Values that are not expanded
+// entities are returned unchanged.
+func expandedIDs(v json.RawMessage) (json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(v, &items); err != nil {
+		return expandedID(v)
+	}
+
+	ids := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		id, err := expandedID(item)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	return json.Marshal(ids)
+}
+
+// expandedID returns the ID of the provided expanded entity, or the value
+// unchanged if it is not an expanded entity.
+func expandedID(v json.RawMessage) (json.RawMessage, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(v), []byte("{")) {
+		return v, nil
+	}
+
+	var ent struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(v, &ent); err != nil {
+		return nil, errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	return json.Marshal(ent.ID)
+}
+
+// loadDetailSections loads the wanted sections of the GameDetail separately,
+// through the Reader fields of the provided Client.
+func loadDetailSections(c *Client, d *GameDetail, want map[GameSection]bool) {
+	g := d.Game
+
+	loaders := map[GameSection]func() error{
+		SectionCover: func() error {
+			if g.Cover == 0 {
+				return nil
+			}
+			var err error
+			d.Cover, err = c.Covers.Get(g.Cover, SetFields("*"))
+			return err
+		},
+		SectionScreenshots: func() error {
+			return listDetail(g.Screenshots, func(ids []int, opts ...Option) error {
+				v, err := c.Screenshots.List(ids, opts...)
+				d.Screenshots = append(d.Screenshots, v...)
+				return err
+			})
+		},
+		SectionArtworks: func() error {
+			return listDetail(g.Artworks, func(ids []int, opts ...Option) error {
+				v, err := c.Artworks.List(ids, opts...)
+				d.Artworks = append(d.Artworks, v...)
+				return err
+			})
+		},
+		SectionGenres: func() error {
+			return listDetail(g.Genres, func(ids []int, opts ...Option) error {
+				v, err := c.Genres.List(ids, opts...)
+				d.Genres = append(d.Genres, v...)
+				return err
+			})
+		},
+		SectionThemes: func() error {
+			return listDetail(g.Themes, func(ids []int, opts ...Option) error {
+				v, err := c.Themes.List(ids, opts...)
+				d.Themes = append(d.Themes, v...)
+				return err
+			})
+		},
+		SectionPlatforms: func() error {
+			return listDetail(g.Platforms, func(ids []int, opts ...Option) error {
+				v, err := c.Platforms.List(ids, opts...)
+				d.Platforms = append(d.Platforms, v...)
+				return err
+			})
+		},
+		SectionReleaseDates: func() error {
+			return listDetail(g.ReleaseDates, func(ids []int, opts ...Option) error {
+				v, err := c.ReleaseDates.List(ids, opts...)
+				d.ReleaseDates = append(d.ReleaseDates, v...)
+				return err
+			})
+		},
+		SectionAgeRatings: func() error {
+			return listDetail(g.AgeRatings, func(ids []int, opts ...Option) error {
+				v, err := c.AgeRatings.List(ids, opts...)
+				d.AgeRatings = append(d.AgeRatings, v...)
+				return err
+			})
+		},
+		SectionWebsites: func() error {
+			return listDetail(g.Websites, func(ids []int, opts ...Option) error {
+				v, err := c.Websites.List(ids, opts...)
+				d.Websites = append(d.Websites, v...)
+				return err
+			})
+		},
+		SectionVideos: func() error {
+			return listDetail(g.Videos, func(ids []int, opts ...Option) error {
+				v, err := c.GameVideos.List(ids, opts...)
+				d.Videos = append(d.Videos, v...)
+				return err
+			})
+		},
+		SectionMultiplayerModes: func() error {
+			return listDetail(g.MultiplayerModes, func(ids []int, opts ...Option) error {
+				v, err := c.MultiplayerModes.List(ids, opts...)
+				d.MultiplayerModes = append(d.MultiplayerModes, v...)
+				return err
+			})
+		},
+		SectionTimeToBeat: func() error {
+			if g.TimeToBeat == 0 {
+				return nil
+			}
+			var err error
+			d.TimeToBeat, err = c.TimeToBeats.Get(g.TimeToBeat, SetFields("*"))
+			return err
+		},
+	}
+
+	var mu sync.Mutex
+	fail := func(sec GameSection, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		d.Errors[sec] = errors.Wrapf(err, "cannot load %s of Game with ID %v", sec, g.ID)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, detailConcurrency)
+	for _, sec := range GameSections {
+		load, ok := loaders[sec]
+		if !ok || !want[sec] {
+			continue
+		}
+
+		wg.Add(1)
+		go func(sec GameSection, load func() error) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := load(); err != nil && errors.Cause(err) != ErrNoResults {
+				fail(sec, err)
+			}
+		}(sec, load)
+	}
+
+	if want[SectionInvolvedCompanies] || want[SectionCompanies] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			sec, err := loadCompanies(c, d, want[SectionCompanies])
+			if err == nil {
+				return
+			}
+			if sec == SectionInvolvedCompanies && want[SectionCompanies] {
+				fail(SectionCompanies, err)
+			}
+			if want[sec] {
+				fail(sec, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// loadCompanies loads the InvolvedCompanies of the GameDetail and, if
+// requested, the Companies they reference. The section that failed to load
+// is returned along with its error.
+func loadCompanies(c *Client, d *GameDetail, companies bool) (GameSection, error) {
+	err := listDetail(d.Game.InvolvedCompanies, func(ids []int, opts ...Option) error {
+		v, err := c.InvolvedCompanies.List(ids, opts...)
+		d.InvolvedCompanies = append(d.InvolvedCompanies, v...)
+		return err
+	})
+	if err != nil {
+		return SectionInvolvedCompanies, err
+	}
+	if !companies {
+		return "", nil
+	}
+
+	var ids []int
+	seen := make(map[int]bool)
+	for _, ic := range d.InvolvedCompanies {
+		if ic.Company != 0 && !seen[ic.Company] {
+			seen[ic.Company] = true
+			ids = append(ids, ic.Company)
+		}
+	}
+
+	return SectionCompanies, listDetail(ids, func(ids []int, opts ...Option) error {
+		v, err := c.Companies.List(ids, opts...)
+		d.Companies = append(d.Companies, v...)
+		return err
+	})
+}
+
+// listDetail calls list with batches of the provided IDs along with the
+// options retrieving every field of each entity in the batch. Batches with
+// no results are ignored.
+func listDetail(ids []int, list func(ids []int, opts ...Option) error) error {
+	return batchIDs(ids, func(batch []int) error {
+		err := list(batch, SetFields("*"), SetLimit(len(batch)))
+		if errors.Cause(err) == ErrNoResults {
+			return nil
+		}
+		return err
+	})
+}
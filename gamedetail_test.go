@@ -0,0 +1,212 @@
+package igdb_test
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+	"github.com/pkg/errors"
+)
+
+// testDetailServer returns a Server loaded with a Game and the entities it
+// references. Every reference but the Game's time to beat can be expanded.
+func testDetailServer(t *testing.T) *igdbtest.Server {
+	srv := igdbtest.NewServer()
+
+	err := srv.LoadAll(map[string]string{
+		string(igdb.EndpointGame): `[{"id": 7, "name": "Celeste", "cover": 70, "screenshots": [71, 72], "genres": [8, 9],
+			"involved_companies": [30, 31], "videos": [40], "time_to_beat": 50, "websites": [60]}]`,
+		string(igdb.EndpointCover):           `[{"id": 70, "game": 7, "image_id": "co70"}]`,
+		string(igdb.EndpointScreenshot):      `[{"id": 71, "game": 7, "image_id": "sc71"}, {"id": 72, "game": 7, "image_id": "sc72"}, {"id": 73, "game": 8}]`,
+		string(igdb.EndpointGenre):           `[{"id": 8, "name": "Platform"}, {"id": 9, "name": "Indie"}]`,
+		string(igdb.EndpointInvolvedCompany): `[{"id": 30, "game": 7, "company": 3, "developer": true}, {"id": 31, "game": 7, "company": 3, "publisher": true}]`,
+		string(igdb.EndpointCompany):         `[{"id": 3, "name": "Matt Makes Games"}, {"id": 4, "name": "Other"}]`,
+		string(igdb.EndpointGameVideo):       `[{"id": 40, "game": 7, "video_id": "iofYDsA2rqg"}]`,
+		string(igdb.EndpointTimeToBeat):      `[{"id": 50, "game": 7, "normally": 36000}]`,
+	})
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	refs := map[string]string{
+		"cover":              string(igdb.EndpointCover),
+		"screenshots":        string(igdb.EndpointScreenshot),
+		"genres":             string(igdb.EndpointGenre),
+		"involved_companies": string(igdb.EndpointInvolvedCompany),
+		"videos":             string(igdb.EndpointGameVideo),
+		"websites":           string(igdb.EndpointWebsite),
+	}
+	for field, end := range refs {
+		srv.Reference(string(igdb.EndpointGame), field, end)
+	}
+	srv.Reference(string(igdb.EndpointInvolvedCompany), "company", string(igdb.EndpointCompany))
+
+	return srv
+}
+
+// recordEndpoints is a Middleware that records the endpoint of each Call in
+// sorted order.
+func recordEndpoints(ends *[]string) igdb.Middleware {
+	var mu sync.Mutex
+	return func(next igdb.Handler) igdb.Handler {
+		return func(call *igdb.Call) error {
+			mu.Lock()
+			*ends = append(*ends, string(call.Endpoint))
+			sort.Strings(*ends)
+			mu.Unlock()
+			return next(call)
+		}
+	}
+}
+
+func TestLoadGameDetail(t *testing.T) {
+	srv := testDetailServer(t)
+	defer srv.Close()
+
+	var ends []string
+	c := igdb.NewClient("igdbtest", srv.Client(), igdb.WithMiddleware(recordEndpoints(&ends)))
+
+	d, err := igdb.LoadGameDetail(c, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The time to beat is not expanded and the Website does not exist, so
+	// both are requested separately.
+	want := []string{string(igdb.EndpointGame), string(igdb.EndpointTimeToBeat), string(igdb.EndpointWebsite)}
+	if !reflect.DeepEqual(ends, want) {
+		t.Errorf("got: <%v>, want: <%v>", ends, want)
+	}
+
+	if d.Game.Name != "Celeste" {
+		t.Errorf("got: <%v>, want: <%v>", d.Game.Name, "Celeste")
+	}
+	if d.Cover == nil || d.Cover.ImageID != "co70" {
+		t.Errorf("got: <%v>, want cover: <%v>", d.Cover, "co70")
+	}
+	if len(d.Screenshots) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(d.Screenshots), 2)
+	}
+	if len(d.Genres) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(d.Genres), 2)
+	}
+	if len(d.InvolvedCompanies) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(d.InvolvedCompanies), 2)
+	}
+	if len(d.Companies) != 1 || d.Company(3) == nil {
+		t.Errorf("got: <%v>, want company: <%v>", d.Companies, 3)
+	}
+	if len(d.Videos) != 1 || d.Videos[0].ID != 40 {
+		t.Errorf("got: <%v>, want video: <%v>", d.Videos, 40)
+	}
+	if d.TimeToBeat == nil || d.TimeToBeat.Normally != 36000 {
+		t.Errorf("got: <%v>, want: <%v>", d.TimeToBeat, 36000)
+	}
+	if d.Artworks != nil || d.Platforms != nil {
+		t.Errorf("got: <%v, %v>, want unreferenced sections empty", d.Artworks, d.Platforms)
+	}
+
+	// The Game references a Website that does not exist.
+	if d.Websites != nil {
+		t.Errorf("got: <%v>, want: <%v>", d.Websites, nil)
+	}
+	if err := d.Err(); err != nil {
+		t.Errorf("got: <%v>, want: <%v>", err, nil)
+	}
+}
+
+func TestLoadGameDetailInclude(t *testing.T) {
+	srv := testDetailServer(t)
+	defer srv.Close()
+
+	d, err := igdb.LoadGameDetail(srv.NewClient(), 7, igdb.SectionCover, igdb.SectionCompanies)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Cover == nil {
+		t.Errorf("got: <%v>, want a cover", d.Cover)
+	}
+	if len(d.Companies) != 1 || len(d.InvolvedCompanies) != 2 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", len(d.Companies), len(d.InvolvedCompanies), 1, 2)
+	}
+	if d.Screenshots != nil || d.TimeToBeat != nil {
+		t.Errorf("got: <%v, %v>, want sections not included empty", d.Screenshots, d.TimeToBeat)
+	}
+	if d.Game.Cover != 70 || len(d.Game.InvolvedCompanies) != 2 {
+		t.Errorf("got: <%v>, want the IDs of the expanded fields", d.Game)
+	}
+}
+
+func TestLoadGameDetailErrors(t *testing.T) {
+	srv := testDetailServer(t)
+	defer srv.Close()
+
+	// The expanded request fails, so every section is loaded separately.
+	errFailed := errors.New("failed")
+	fail := func(next igdb.Handler) igdb.Handler {
+		return func(call *igdb.Call) error {
+			switch {
+			case call.Endpoint == igdb.EndpointGame && strings.Contains(call.Query, ".*"):
+				return errFailed
+			case call.Endpoint == igdb.EndpointScreenshot || call.Endpoint == igdb.EndpointInvolvedCompany:
+				return errFailed
+			}
+			return next(call)
+		}
+	}
+	c := igdb.NewClient("igdbtest", srv.Client(), igdb.WithMiddleware(fail))
+
+	d, err := igdb.LoadGameDetail(c, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sec := range []igdb.GameSection{igdb.SectionScreenshots, igdb.SectionInvolvedCompanies, igdb.SectionCompanies} {
+		if errors.Cause(d.Errors[sec]) != errFailed {
+			t.Errorf("got: <%v>, want: <%v>", errors.Cause(d.Errors[sec]), errFailed)
+		}
+	}
+	if len(d.Errors) != 3 {
+		t.Errorf("got: <%v>, want: <%v>", d.Errors, 3)
+	}
+	if errors.Cause(d.Err()) != errFailed {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(d.Err()), errFailed)
+	}
+	if d.Cover == nil || len(d.Genres) != 2 {
+		t.Errorf("got: <%v, %v>, want other sections loaded", d.Cover, d.Genres)
+	}
+
+	// A section requested separately after the expanded request reports
+	// its own error.
+	failTime := func(next igdb.Handler) igdb.Handler {
+		return func(call *igdb.Call) error {
+			if call.Endpoint == igdb.EndpointTimeToBeat {
+				return errFailed
+			}
+			return next(call)
+		}
+	}
+	d, err = igdb.LoadGameDetail(igdb.NewClient("igdbtest", srv.Client(), igdb.WithMiddleware(failTime)), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Errors) != 1 || errors.Cause(d.Errors[igdb.SectionTimeToBeat]) != errFailed {
+		t.Errorf("got: <%v>, want: <%v>", d.Errors, errFailed)
+	}
+	if d.Cover == nil || len(d.Companies) != 1 {
+		t.Errorf("got: <%v, %v>, want expanded sections loaded", d.Cover, d.Companies)
+	}
+
+	if _, err := igdb.LoadGameDetail(c, 99); errors.Cause(err) != igdb.ErrNoResults {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNoResults)
+	}
+	if _, err := igdb.LoadGameDetail(c, -1); errors.Cause(err) != igdb.ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNegativeID)
+	}
+}
@@ -42,6 +42,20 @@ func (gs *GameEngineService) Get(id int, opts ...Option) (*GameEngine, error) {
 	return eng[0], nil
 }
 
+// GameEngineBySlug returns a single GameEngine identified by the provided slug
+// using the provided GameEngineReader, such as the GameEngines field of a
+// Client. Provide the SetFields functional option if you need to specify which
+// fields to retrieve. If the slug does not match any GameEngines, an error is
+// returned.
+func GameEngineBySlug(r GameEngineReader, slug string, opts ...Option) (*GameEngine, error) {
+	eng, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameEngine with slug %s", slug)
+	}
+
+	return eng[0], nil
+}
+
 // List returns a list of GameEngines identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a GameEngine is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestGameEngineService_Get(t *testing.T) {
 	}
 }
 
+func TestGameEngineBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameEngineGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameEngine, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name           string
+		file           string
+		slug           string
+		opts           []Option
+		wantGameEngine *GameEngine
+		wantErr        error
+	}{
+		{"Valid response", testGameEngineGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			eng, err := GameEngineBySlug(c.GameEngines, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(eng, test.wantGameEngine) {
+				t.Errorf("got: <%v>, \nwant: <%v>", eng, test.wantGameEngine)
+			}
+		})
+	}
+}
+
 func TestGameEngineService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameEngineList)
 	if err != nil {
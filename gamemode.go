@@ -37,6 +37,19 @@ func (gs *GameModeService) Get(id int, opts ...Option) (*GameMode, error) {
 	return mode[0], nil
 }
 
+// GameModeBySlug returns a single GameMode identified by the provided slug
+// using the provided GameModeReader, such as the GameModes field of a Client.
+// Provide the SetFields functional option if you need to specify which fields
+// to retrieve. If the slug does not match any GameModes, an error is returned.
+func GameModeBySlug(r GameModeReader, slug string, opts ...Option) (*GameMode, error) {
+	mode, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameMode with slug %s", slug)
+	}
+
+	return mode[0], nil
+}
+
 // List returns a list of GameModes identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a GameMode is ignored. If none of the IDs
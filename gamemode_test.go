@@ -57,6 +57,49 @@ func TestGameModeService_Get(t *testing.T) {
 	}
 }
 
+func TestGameModeBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameModeGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameMode, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name         string
+		file         string
+		slug         string
+		opts         []Option
+		wantGameMode *GameMode
+		wantErr      error
+	}{
+		{"Valid response", testGameModeGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			mode, err := GameModeBySlug(c.GameModes, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(mode, test.wantGameMode) {
+				t.Errorf("got: <%v>, \nwant: <%v>", mode, test.wantGameMode)
+			}
+		})
+	}
+}
+
 func TestGameModeService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameModeList)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 // GameVideo represents a video associated with a particular game.
 // For more information visit: https://api-docs.igdb.com/#game-video
 type GameVideo struct {
+	ID      int    `json:"id"`
 	Game    int    `json:"game"`
 	Name    string `json:"name"`
 	VideoID string `json:"video_id"`
@@ -37,6 +37,19 @@ func (gs *GenreService) Get(id int, opts ...Option) (*Genre, error) {
 	return gen[0], nil
 }
 
+// GenreBySlug returns a single Genre identified by the provided slug using the
+// provided GenreReader, such as the Genres field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Genres, an error is returned.
+func GenreBySlug(r GenreReader, slug string, opts ...Option) (*Genre, error) {
+	gen, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Genre with slug %s", slug)
+	}
+
+	return gen[0], nil
+}
+
 // List returns a list of Genres identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Genre is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestGenreService_Get(t *testing.T) {
 	}
 }
 
+func TestGenreBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGenreGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Genre, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name      string
+		file      string
+		slug      string
+		opts      []Option
+		wantGenre *Genre
+		wantErr   error
+	}{
+		{"Valid response", testGenreGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			gen, err := GenreBySlug(c.Genres, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gen, test.wantGenre) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gen, test.wantGenre)
+			}
+		})
+	}
+}
+
 func TestGenreService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGenreList)
 	if err != nil {
@@ -0,0 +1,573 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// Errors returned when traversing the reference graph.
+var (
+	// ErrUnknownReference occurs when following a field that is not known to
+	// reference another endpoint.
+	ErrUnknownReference = errors.New("unknown reference field")
+	// ErrDepthLimit occurs when a traversal would follow more references than
+	// the MaxDepth of its Graph allows.
+	ErrDepthLimit = errors.New("traversal exceeds depth limit")
+)
+
+// DefaultGraphMaxDepth is the MaxDepth of a new Graph.
+const DefaultGraphMaxDepth = 8
+
+// graphRefs holds the endpoint referenced by each reference field of each
+// endpoint, keyed by field name as returned by the IGDB.
+var graphRefs = map[endpoint]map[string]endpoint{
+	EndpointAchievement: {
+		"achievement_icon": EndpointAchievementIcon,
+		"game":             EndpointGame,
+	},
+	EndpointAgeRating: {
+		"content_descriptions": EndpointAgeRatingContent,
+	},
+	EndpointAlternativeName: {"game": EndpointGame},
+	EndpointArtwork:         {"game": EndpointGame},
+	EndpointCharacter: {
+		"games":    EndpointGame,
+		"mug_shot": EndpointCharacterMugshot,
+		"people":   EndpointPerson,
+	},
+	EndpointCollection: {"games": EndpointGame},
+	EndpointCompany: {
+		"changed_company_id": EndpointCompany,
+		"developed":          EndpointGame,
+		"logo":               EndpointCompanyLogo,
+		"parent":             EndpointCompany,
+		"published":          EndpointGame,
+		"websites":           EndpointCompanyWebsite,
+	},
+	EndpointCover:        {"game": EndpointGame},
+	EndpointExternalGame: {"game": EndpointGame},
+	EndpointFeed: {
+		"games": EndpointGame,
+		"pulse": EndpointPulse,
+	},
+	EndpointFranchise: {"games": EndpointGame},
+	EndpointGame: {
+		"age_ratings":           EndpointAgeRating,
+		"alternative_names":     EndpointAlternativeName,
+		"artworks":              EndpointArtwork,
+		"bundles":               EndpointGame,
+		"collection":            EndpointCollection,
+		"cover":                 EndpointCover,
+		"dlcs":                  EndpointGame,
+		"expansions":            EndpointGame,
+		"external_games":        EndpointExternalGame,
+		"franchise":             EndpointFranchise,
+		"franchises":            EndpointFranchise,
+		"game_engines":          EndpointGameEngine,
+		"game_modes":            EndpointGameMode,
+		"genres":                EndpointGenre,
+		"involved_companies":    EndpointInvolvedCompany,
+		"keywords":              EndpointKeyword,
+		"multiplayer_modes":     EndpointMultiplayerMode,
+		"parent_game":           EndpointGame,
+		"platforms":             EndpointPlatform,
+		"player_perspectives":   EndpointPlayerPerspective,
+		"release_dates":         EndpointReleaseDate,
+		"screenshots":           EndpointScreenshot,
+		"similar_games":         EndpointGame,
+		"standalone_expansions": EndpointGame,
+		"themes":                EndpointTheme,
+		"time_to_beat":          EndpointTimeToBeat,
+		"version_parent":        EndpointGame,
+		"videos":                EndpointGameVideo,
+		"websites":              EndpointWebsite,
+	},
+	EndpointGameEngine: {
+		"companies": EndpointCompany,
+		"logo":      EndpointGameEngineLogo,
+		"platforms": EndpointPlatform,
+	},
+	EndpointGameVersion: {
+		"features": EndpointGameVersionFeature,
+		"game":     EndpointGame,
+		"games":    EndpointGame,
+	},
+	EndpointGameVideo: {"game": EndpointGame},
+	EndpointInvolvedCompany: {
+		"company": EndpointCompany,
+		"game":    EndpointGame,
+	},
+	EndpointMultiplayerMode: {"platform": EndpointPlatform},
+	EndpointPage: {
+		"background": EndpointPageBackground,
+		"company":    EndpointCompany,
+		"feed":       EndpointFeed,
+		"game":       EndpointGame,
+		"page_logo":  EndpointPageLogo,
+		"websites":   EndpointPageWebsite,
+	},
+	EndpointPlatform: {
+		"platform_logo":  EndpointPlatformLogo,
+		"product_family": EndpointProductFamily,
+		"versions":       EndpointPlatformVersion,
+		"websites":       EndpointPlatformWebsite,
+	},
+	EndpointPlatformVersion: {
+		"companies":                      EndpointPlatformVersionCompany,
+		"main_manufacturer":              EndpointPlatformVersionCompany,
+		"platform_logo":                  EndpointPlatformLogo,
+		"platform_version_release_dates": EndpointPlatformVersionReleaseDate,
+	},
+	EndpointPlatformVersionCompany: {"company": EndpointCompany},
+	EndpointPulse:                  {"pulse_source": EndpointPulseSource},
+	EndpointReleaseDate: {
+		"game":     EndpointGame,
+		"platform": EndpointPlatform,
+	},
+	EndpointScreenshot: {"game": EndpointGame},
+	EndpointTimeToBeat: {"game": EndpointGame},
+	EndpointTitle:      {"games": EndpointGame},
+	EndpointWebsite:    {"game": EndpointGame},
+
+	EndpointCredit: {
+		"character": EndpointCharacter,
+		"company":   EndpointCompany,
+		"game":      EndpointGame,
+		"person":    EndpointPerson,
+	},
+	EndpointList: {
+		"list_entries":  EndpointListEntry,
+		"listed_games":  EndpointGame,
+		"similar_lists": EndpointList,
+	},
+	EndpointListEntry: {
+		"game":     EndpointGame,
+		"list":     EndpointList,
+		"platform": EndpointPlatform,
+	},
+	EndpointPerson: {
+		"characters":     EndpointCharacter,
+		"credited_games": EndpointGame,
+		"mug_shot":       EndpointPersonMugshot,
+		"parent":         EndpointPerson,
+		"voice_acted":    EndpointCharacter,
+		"websites":       EndpointPersonWebsite,
+	},
+	EndpointReview: {
+		"game":     EndpointGame,
+		"platform": EndpointPlatform,
+		"video":    EndpointReviewVideo,
+	},
+}
+
+// Graph traverses the references between IGDB entities, such as from a
+// Game to its InvolvedCompanies and on to their Companies. Entities are
+// retrieved with every field and held as the Nodes of a Subgraph.
+type Graph struct {
+	// MaxDepth is the largest number of references a traversal may follow
+	// from its starting entities.
+	MaxDepth int
+
+	client *Client
+}
+
+// Graph returns a Graph traversing the references between the entities
+// retrieved by the Client.
+func (c *Client) Graph() *Graph {
+	return &Graph{MaxDepth: DefaultGraphMaxDepth, client: c}
+}
+
+// References returns the endpoint referenced by each reference field of the
+// provided endpoint. Nil is returned for an endpoint with no known
+// references.
+func (g *Graph) References(end endpoint) map[string]endpoint {
+	refs, ok := graphRefs[end]
+	if !ok {
+		return nil
+	}
+
+	cp := make(map[string]endpoint, len(refs))
+	for f, e := range refs {
+		cp[f] = e
+	}
+
+	return cp
+}
+
+// From returns a Traversal starting at the entities of the provided
+// endpoint identified by the provided IGDB IDs.
+func (g *Graph) From(end endpoint, ids ...int) *Traversal {
+	return &Traversal{graph: g, end: end, ids: ids}
+}
+
+// Traversal walks the references between IGDB entities from a set of
+// starting entities. Every entity is retrieved at most once per traversal.
+// An entity reached again by a later step is followed from that step without
+// being retrieved again, while a reference already recorded as an Edge is
+// not followed again, so references that loop back end the walk.
+type Traversal struct {
+	graph *Graph
+	end   endpoint
+	ids   []int
+}
+
+// Follow follows the provided path of reference fields from the starting
+// entities, one field per step (e.g. "involved_companies", "company",
+// "logo"), and returns the Subgraph of every entity visited along the way.
+// An error is returned if a field is not a known reference of the endpoint
+// reached at its step, or if the path is longer than the Graph's MaxDepth.
+func (t *Traversal) Follow(path ...string) (*Subgraph, error) {
+	return t.walk(len(path), true, func(step int) []string {
+		return path[step : step+1]
+	})
+}
+
+// Expand follows any of the provided reference fields from every visited
+// entity, step after step, until the provided depth is reached or no new
+// references are found (e.g. "parent" to climb a chain of Companies). Fields
+// that are not references of an entity's endpoint are ignored for that
+// entity. An error is returned if the depth exceeds the Graph's MaxDepth.
+func (t *Traversal) Expand(depth int, fields ...string) (*Subgraph, error) {
+	return t.walk(depth, false, func(int) []string {
+		return fields
+	})
+}
+
+// walk visits the starting entities and then follows the fields returned
+// for each step up to the provided depth. If strict is true, every field
+// must be a known reference of the endpoints it is followed from.
+func (t *Traversal) walk(depth int, strict bool, fieldsAt func(step int) []string) (*Subgraph, error) {
+	if depth < 0 || depth > t.graph.MaxDepth {
+		return nil, errors.Wrapf(ErrDepthLimit, "cannot follow %d references with a maximum depth of %d", depth, t.graph.MaxDepth)
+	}
+
+	start, err := t.graph.fetch(t.end, dedupeIDs(t.ids), 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot start traversal at %s IDs %v", t.end, t.ids)
+	}
+	if len(start) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot start traversal at %s IDs %v", t.end, t.ids)
+	}
+
+	sg := newSubgraph()
+	sg.add(start...)
+
+	seen := make(map[Edge]bool)
+	frontier := start
+	for step := 0; step < depth && len(frontier) > 0; step++ {
+		var pending []Edge
+		var next []NodeKey
+		want := make(map[endpoint][]int)
+		queued := make(map[NodeKey]bool)
+
+		for _, n := range frontier {
+			for _, field := range fieldsAt(step) {
+				target, ok := graphRefs[n.Endpoint][field]
+				if !ok {
+					if strict {
+						return nil, errors.Wrapf(ErrUnknownReference, "cannot follow '%s' from %s", field, n.Endpoint)
+					}
+					continue
+				}
+
+				for _, id := range n.refs(field) {
+					to := NodeKey{Endpoint: target, ID: id}
+					e := Edge{From: n.NodeKey, Field: field, To: to}
+					if seen[e] {
+						continue
+					}
+					seen[e] = true
+					pending = append(pending, e)
+
+					if queued[to] {
+						continue
+					}
+					queued[to] = true
+					next = append(next, to)
+					if sg.index[to] == nil {
+						want[target] = append(want[target], id)
+					}
+				}
+			}
+		}
+
+		ends := make([]string, 0, len(want))
+		for end := range want {
+			ends = append(ends, string(end))
+		}
+		sort.Strings(ends)
+
+		for _, end := range ends {
+			nodes, err := t.graph.fetch(endpoint(end), want[endpoint(end)], step+1)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot follow references to %s", end)
+			}
+			sg.add(nodes...)
+		}
+
+		// Entities visited by an earlier step are followed again from this
+		// one without being retrieved again.
+		frontier = nil
+		for _, to := range next {
+			if n := sg.index[to]; n != nil {
+				frontier = append(frontier, n)
+			}
+		}
+
+		for _, e := range pending {
+			if sg.index[e.To] != nil {
+				sg.Edges = append(sg.Edges, e)
+			}
+		}
+	}
+
+	return sg, nil
+}
+
+// fetch retrieves every field of the entities of the provided endpoint with
+// the provided IDs in batches and returns them as Nodes at the provided
+// depth. IDs that do not match any entity are ignored.
+func (g *Graph) fetch(end endpoint, ids []int, depth int) ([]*Node, error) {
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+	if len(ids) == 0 {
+		return nil, ErrEmptyIDs
+	}
+
+	var nodes []*Node
+	err := batchIDs(ids, func(batch []int) error {
+		var raw []json.RawMessage
+		err := g.client.get(end, &raw,
+			SetFields("*"),
+			SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(batch)...),
+			SetLimit(len(batch)),
+		)
+		if errors.Cause(err) == ErrNoResults {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, r := range raw {
+			n, err := newNode(end, r, depth)
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	return nodes, nil
+}
+
+// dedupeIDs returns the provided IDs without repeats, in order.
+func dedupeIDs(ids []int) []int {
+	var out []int
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+
+	return out
+}
+
+// NodeKey identifies an IGDB entity in a Subgraph.
+type NodeKey struct {
+	Endpoint endpoint `json:"endpoint"`
+	ID       int      `json:"id"`
+}
+
+// Node is an IGDB entity visited by a traversal.
+type Node struct {
+	NodeKey
+	// Depth is the number of references followed to reach the entity.
+	Depth int `json:"depth"`
+	// Data is the entity as returned by the IGDB.
+	Data json.RawMessage `json:"data"`
+
+	fields map[string]json.RawMessage
+}
+
+// newNode returns the Node of the provided entity of the provided endpoint.
+func newNode(end endpoint, data json.RawMessage, depth int) (*Node, error) {
+	n := &Node{Depth: depth, Data: data}
+	if err := json.Unmarshal(data, &n.fields); err != nil {
+		return nil, errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	n.Endpoint = end
+	if err := json.Unmarshal(n.fields["id"], &n.ID); err != nil {
+		return nil, errors.Wrapf(errInvalidJSON, "cannot decode ID of %s entity", end)
+	}
+
+	return n, nil
+}
+
+// Decode decodes the entity into the value pointed to by v, such as a
+// *Game for a Node of the Game endpoint.
+func (n *Node) Decode(v interface{}) error {
+	if err := json.Unmarshal(n.Data, v); err != nil {
+		return errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	return nil
+}
+
+// refs returns the IDs held by the provided reference field of the entity,
+// whether it holds a single ID or a list of IDs. Zero IDs are left out.
+func (n *Node) refs(field string) []int {
+	raw := bytes.TrimSpace(n.fields[field])
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var ids []int
+	if raw[0] == openBracketASCII {
+		if err := json.Unmarshal(raw, &ids); err != nil {
+			return nil
+		}
+	} else {
+		var id int
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return nil
+		}
+		ids = []int{id}
+	}
+
+	out := ids[:0]
+	for _, id := range ids {
+		if id > 0 {
+			out = append(out, id)
+		}
+	}
+
+	return out
+}
+
+// Edge is a reference from one entity to another in a Subgraph.
+type Edge struct {
+	From  NodeKey `json:"from"`
+	Field string  `json:"field"`
+	To    NodeKey `json:"to"`
+}
+
+// Subgraph contains the entities visited by a traversal and the references
+// followed between them. A Subgraph encodes to JSON as its lists of nodes
+// and edges.
+type Subgraph struct {
+	// Nodes holds the visited entities in the order they were visited.
+	Nodes []*Node `json:"nodes"`
+	// Edges holds the followed references between visited entities.
+	Edges []Edge `json:"edges"`
+
+	index map[NodeKey]*Node
+}
+
+// newSubgraph returns an empty Subgraph.
+func newSubgraph() *Subgraph {
+	return &Subgraph{index: make(map[NodeKey]*Node)}
+}
+
+// add adds the provided Nodes to the Subgraph.
+func (sg *Subgraph) add(nodes ...*Node) {
+	for _, n := range nodes {
+		if sg.index[n.NodeKey] != nil {
+			continue
+		}
+		sg.index[n.NodeKey] = n
+		sg.Nodes = append(sg.Nodes, n)
+	}
+}
+
+// Node returns the visited entity of the provided endpoint with the
+// provided ID, or nil if it was not visited.
+func (sg *Subgraph) Node(end endpoint, id int) *Node {
+	return sg.index[NodeKey{Endpoint: end, ID: id}]
+}
+
+// Endpoint returns the visited entities of the provided endpoint in the
+// order they were visited.
+func (sg *Subgraph) Endpoint(end endpoint) []*Node {
+	var nodes []*Node
+	for _, n := range sg.Nodes {
+		if n.Endpoint == end {
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+// Neighbors returns the entities the provided entity references through
+// the provided field.
+func (sg *Subgraph) Neighbors(from NodeKey, field string) []*Node {
+	var nodes []*Node
+	for _, e := range sg.Edges {
+		if e.From == from && e.Field == field {
+			nodes = append(nodes, sg.index[e.To])
+		}
+	}
+
+	return nodes
+}
+
+// HasCycle returns true if the followed references loop back to an entity
+// they started from, such as a Company that is its own parent's parent.
+func (sg *Subgraph) HasCycle() bool {
+	adj := make(map[NodeKey][]NodeKey)
+	for _, e := range sg.Edges {
+		adj[e.From] = append(adj[e.From], e.To)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[NodeKey]int)
+
+	var visit func(k NodeKey) bool
+	visit = func(k NodeKey) bool {
+		state[k] = visiting
+		for _, next := range adj[k] {
+			switch state[next] {
+			case visiting:
+				return true
+			case unvisited:
+				if visit(next) {
+					return true
+				}
+			}
+		}
+		state[k] = done
+		return false
+	}
+
+	for _, n := range sg.Nodes {
+		if state[n.NodeKey] == unvisited && visit(n.NodeKey) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,243 @@
+package igdb_test
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+	"github.com/pkg/errors"
+)
+
+// testGraphServer returns a Server loaded with Games, their Franchise,
+// covers, and InvolvedCompanies, and a chain of parent Companies that loops
+// back on itself.
+func testGraphServer(t *testing.T) *igdbtest.Server {
+	srv := igdbtest.NewServer()
+
+	err := srv.LoadAll(map[string]string{
+		string(igdb.EndpointGame): `[{"id": 7, "name": "Celeste", "involved_companies": [30, 31], "similar_games": [8], "franchises": [20], "cover": 70},
+			{"id": 8, "name": "Towerfall", "involved_companies": [32], "similar_games": [7], "franchises": [20], "cover": 80}]`,
+		string(igdb.EndpointFranchise): `[{"id": 20, "name": "Matt Makes Games", "games": [7, 8]}]`,
+		string(igdb.EndpointCover):     `[{"id": 70, "image_id": "co70"}, {"id": 80, "image_id": "co80"}]`,
+		string(igdb.EndpointInvolvedCompany): `[{"id": 30, "game": 7, "company": 3}, {"id": 31, "game": 7, "company": 4},
+			{"id": 32, "game": 8, "company": 3}]`,
+		string(igdb.EndpointCompany): `[{"id": 3, "name": "Matt Makes Games", "logo": 90, "parent": 4},
+			{"id": 4, "name": "Extremely OK Games", "logo": 91, "parent": 5}, {"id": 5, "name": "Holding", "parent": 3}]`,
+		string(igdb.EndpointCompanyLogo): `[{"id": 90, "image_id": "cl90"}]`,
+	})
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+
+	return srv
+}
+
+func TestTraversal_Follow(t *testing.T) {
+	srv := testGraphServer(t)
+	defer srv.Close()
+
+	sg, err := srv.NewClient().Graph().From(igdb.EndpointGame, 7).Follow("involved_companies", "company", "logo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		end  string
+		want int
+	}{
+		{string(igdb.EndpointGame), 1},
+		{string(igdb.EndpointInvolvedCompany), 2},
+		{string(igdb.EndpointCompany), 2},
+		// Only one of the two referenced logos exists.
+		{string(igdb.EndpointCompanyLogo), 1},
+	}
+	for _, test := range tests {
+		var got int
+		for _, n := range sg.Nodes {
+			if string(n.Endpoint) == test.end {
+				got++
+			}
+		}
+		if got != test.want {
+			t.Errorf("%s: got: <%v>, want: <%v>", test.end, got, test.want)
+		}
+	}
+
+	if len(sg.Edges) != 5 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Edges), 5)
+	}
+
+	n := sg.Node(igdb.EndpointCompany, 3)
+	if n == nil {
+		t.Fatalf("got: <%v>, want a Company node", n)
+	}
+	if n.Depth != 2 {
+		t.Errorf("got: <%v>, want: <%v>", n.Depth, 2)
+	}
+
+	var c igdb.Company
+	if err := n.Decode(&c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Name != "Matt Makes Games" {
+		t.Errorf("got: <%v>, want: <%v>", c.Name, "Matt Makes Games")
+	}
+
+	logos := sg.Neighbors(n.NodeKey, "logo")
+	if len(logos) != 1 || logos[0].ID != 90 {
+		t.Errorf("got: <%v>, want logo: <%v>", logos, 90)
+	}
+
+	if sg.HasCycle() {
+		t.Errorf("got: <%v>, want: <%v>", true, false)
+	}
+
+	b, err := json.Marshal(sg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var export struct {
+		Nodes []struct {
+			Endpoint string          `json:"endpoint"`
+			ID       int             `json:"id"`
+			Data     json.RawMessage `json:"data"`
+		} `json:"nodes"`
+		Edges []igdb.Edge `json:"edges"`
+	}
+	if err := json.Unmarshal(b, &export); err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Nodes) != len(sg.Nodes) || len(export.Edges) != len(sg.Edges) {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", len(export.Nodes), len(export.Edges), len(sg.Nodes), len(sg.Edges))
+	}
+	if export.Nodes[0].Endpoint != string(igdb.EndpointGame) || export.Nodes[0].ID != 7 {
+		t.Errorf("got: <%v>, want first node: <%v>", export.Nodes[0], 7)
+	}
+}
+
+func TestTraversal_FollowVisited(t *testing.T) {
+	srv := testGraphServer(t)
+	defer srv.Close()
+
+	var games int32
+	count := func(next igdb.Handler) igdb.Handler {
+		return func(call *igdb.Call) error {
+			if call.Endpoint == igdb.EndpointGame {
+				atomic.AddInt32(&games, 1)
+			}
+			return next(call)
+		}
+	}
+	c := igdb.NewClient("igdbtest", srv.Client(), igdb.WithMiddleware(count))
+
+	// The Franchise leads back to the starting Game, whose cover must be
+	// followed along with the cover of the other Game.
+	sg, err := c.Graph().From(igdb.EndpointGame, 7).Follow("franchises", "games", "cover")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []int{7, 8} {
+		covers := sg.Neighbors(igdb.NodeKey{Endpoint: igdb.EndpointGame, ID: id}, "cover")
+		if len(covers) != 1 || covers[0].ID != id*10 {
+			t.Errorf("got: <%v>, want cover: <%v>", covers, id*10)
+		}
+	}
+	if len(sg.Endpoint(igdb.EndpointGame)) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Endpoint(igdb.EndpointGame)), 2)
+	}
+	// The starting Game is not retrieved again.
+	if games != 2 {
+		t.Errorf("got: <%v> Game requests, want: <%v>", games, 2)
+	}
+	if len(sg.Edges) != 5 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Edges), 5)
+	}
+}
+
+func TestTraversal_FollowErrors(t *testing.T) {
+	srv := testGraphServer(t)
+	defer srv.Close()
+
+	g := srv.NewClient().Graph()
+	g.MaxDepth = 2
+
+	var tests = []struct {
+		name    string
+		ids     []int
+		path    []string
+		wantErr error
+	}{
+		{"Unknown field", []int{7}, []string{"involved_companies", "logo"}, igdb.ErrUnknownReference},
+		{"Path too long", []int{7}, []string{"involved_companies", "company", "logo"}, igdb.ErrDepthLimit},
+		{"No starting IDs", nil, []string{"cover"}, igdb.ErrEmptyIDs},
+		{"Negative ID", []int{-1}, []string{"cover"}, igdb.ErrNegativeID},
+		{"Missing start", []int{99}, []string{"cover"}, igdb.ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := g.From(igdb.EndpointGame, test.ids...).Follow(test.path...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestTraversal_Expand(t *testing.T) {
+	srv := testGraphServer(t)
+	defer srv.Close()
+
+	g := srv.NewClient().Graph()
+
+	// Each Company is retrieved once even though the parents loop.
+	sg, err := g.From(igdb.EndpointCompany, 3).Expand(g.MaxDepth, "parent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sg.Nodes) != 3 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Nodes), 3)
+	}
+	if len(sg.Edges) != 3 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Edges), 3)
+	}
+	if !sg.HasCycle() {
+		t.Errorf("got: <%v>, want: <%v>", false, true)
+	}
+
+	// Fields that do not apply to an endpoint are skipped.
+	sg, err = g.From(igdb.EndpointGame, 7).Expand(2, "similar_games", "involved_companies", "company")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sg.Endpoint(igdb.EndpointGame)) != 2 || len(sg.Endpoint(igdb.EndpointInvolvedCompany)) != 3 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", len(sg.Endpoint(igdb.EndpointGame)), len(sg.Endpoint(igdb.EndpointInvolvedCompany)), 2, 3)
+	}
+	if len(sg.Endpoint(igdb.EndpointCompany)) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(sg.Endpoint(igdb.EndpointCompany)), 2)
+	}
+	if !sg.HasCycle() {
+		t.Errorf("got: <%v>, want: <%v>", false, true)
+	}
+
+	if _, err := g.From(igdb.EndpointGame, 7).Expand(g.MaxDepth+1, "similar_games"); errors.Cause(err) != igdb.ErrDepthLimit {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrDepthLimit)
+	}
+}
+
+func TestGraph_References(t *testing.T) {
+	g := igdb.NewClient("igdbtest", nil).Graph()
+
+	refs := g.References(igdb.EndpointInvolvedCompany)
+	if refs["company"] != igdb.EndpointCompany || refs["game"] != igdb.EndpointGame {
+		t.Errorf("got: <%v>, want company and game references", refs)
+	}
+
+	if refs := g.References(igdb.EndpointGenre); refs != nil {
+		t.Errorf("got: <%v>, want: <%v>", refs, nil)
+	}
+}
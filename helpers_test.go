@@ -0,0 +1,18 @@
+package igdb_test
+
+// Helpers shared by the tests of the igdb_test package. The helpers of the
+// igdb package's own tests are found in testing.go.
+
+// equalInts returns true if both slices hold the same values in order.
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
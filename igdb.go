@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/Henry-Sarabia/apicalypse"
 	"github.com/pkg/errors"
@@ -22,7 +23,9 @@ type service struct {
 // Client wraps an HTTP Client used to communicate with the IGDB,
 // the root URL of the IGDB, and the user's IGDB API key.
 // Client also initializes all the separate services to communicate
-// with each individual IGDB API endpoint.
+// with each individual IGDB API endpoint. Each service is held as its
+// endpoint's Reader interface and may be replaced, for example with a
+// mock from the igdbmock package.
 type Client struct {
 	http      *http.Client
 	rootURL   string
@@ -31,72 +34,76 @@ type Client struct {
 	maxOffset int
 	isPro     bool
 
+	middleware []Middleware
+	tracer     Tracer
+	meter      Meter
+
 	// Services
-	Achievements                *AchievementService
-	AchievementIcons            *AchievementIconService
-	AgeRatings                  *AgeRatingService
-	AgeRatingContents           *AgeRatingContentService
-	AlternativeNames            *AlternativeNameService
-	Artworks                    *ArtworkService
-	Characters                  *CharacterService
-	CharacterMugshots           *CharacterMugshotService
-	Collections                 *CollectionService
-	Companies                   *CompanyService
-	CompanyLogos                *CompanyLogoService
-	CompanyWebsites             *CompanyWebsiteService
-	Covers                      *CoverService
-	ExternalGames               *ExternalGameService
-	Feeds                       *FeedService
-	Franchises                  *FranchiseService
-	Games                       *GameService
-	GameEngines                 *GameEngineService
-	GameEngineLogos             *GameEngineLogoService
-	GameModes                   *GameModeService
-	GameVersions                *GameVersionService
-	GameVersionFeatures         *GameVersionFeatureService
-	GameVersionFeatureValues    *GameVersionFeatureValueService
-	GameVideos                  *GameVideoService
-	Genres                      *GenreService
-	InvolvedCompanies           *InvolvedCompanyService
-	Keywords                    *KeywordService
-	MultiplayerModes            *MultiplayerModeService
-	Pages                       *PageService
-	PageBackgrounds             *PageBackgroundService
-	PageLogos                   *PageLogoService
-	PageWebsites                *PageWebsiteService
-	Platforms                   *PlatformService
-	PlatformLogos               *PlatformLogoService
-	PlatformVersions            *PlatformVersionService
-	PlatformVersionCompanies    *PlatformVersionCompanyService
-	PlatformVersionReleaseDates *PlatformVersionReleaseDateService
-	PlatformWebsites            *PlatformWebsiteService
-	PlayerPerspectives          *PlayerPerspectiveService
-	ProductFamilies             *ProductFamilyService
-	Pulses                      *PulseService
-	PulseGroups                 *PulseGroupService
-	PulseSources                *PulseSourceService
-	PulseURLs                   *PulseURLService
-	ReleaseDates                *ReleaseDateService
-	Screenshots                 *ScreenshotService
-	Themes                      *ThemeService
-	TimeToBeats                 *TimeToBeatService
-	Titles                      *TitleService
-	Websites                    *WebsiteService
+	Achievements                AchievementReader
+	AchievementIcons            AchievementIconReader
+	AgeRatings                  AgeRatingReader
+	AgeRatingContents           AgeRatingContentReader
+	AlternativeNames            AlternativeNameReader
+	Artworks                    ArtworkReader
+	Characters                  CharacterReader
+	CharacterMugshots           CharacterMugshotReader
+	Collections                 CollectionReader
+	Companies                   CompanyReader
+	CompanyLogos                CompanyLogoReader
+	CompanyWebsites             CompanyWebsiteReader
+	Covers                      CoverReader
+	ExternalGames               ExternalGameReader
+	Feeds                       FeedReader
+	Franchises                  FranchiseReader
+	Games                       GameReader
+	GameEngines                 GameEngineReader
+	GameEngineLogos             GameEngineLogoReader
+	GameModes                   GameModeReader
+	GameVersions                GameVersionReader
+	GameVersionFeatures         GameVersionFeatureReader
+	GameVersionFeatureValues    GameVersionFeatureValueReader
+	GameVideos                  GameVideoReader
+	Genres                      GenreReader
+	InvolvedCompanies           InvolvedCompanyReader
+	Keywords                    KeywordReader
+	MultiplayerModes            MultiplayerModeReader
+	Pages                       PageReader
+	PageBackgrounds             PageBackgroundReader
+	PageLogos                   PageLogoReader
+	PageWebsites                PageWebsiteReader
+	Platforms                   PlatformReader
+	PlatformLogos               PlatformLogoReader
+	PlatformVersions            PlatformVersionReader
+	PlatformVersionCompanies    PlatformVersionCompanyReader
+	PlatformVersionReleaseDates PlatformVersionReleaseDateReader
+	PlatformWebsites            PlatformWebsiteReader
+	PlayerPerspectives          PlayerPerspectiveReader
+	ProductFamilies             ProductFamilyReader
+	Pulses                      PulseReader
+	PulseGroups                 PulseGroupReader
+	PulseSources                PulseSourceReader
+	PulseURLs                   PulseURLReader
+	ReleaseDates                ReleaseDateReader
+	Screenshots                 ScreenshotReader
+	Themes                      ThemeReader
+	TimeToBeats                 TimeToBeatReader
+	Titles                      TitleReader
+	Websites                    WebsiteReader
 
 	// Private Services
-	Credits        *CreditService
-	FeedFollows    *FeedFollowService
-	Follows        *FollowService
-	Lists          *ListService
-	ListEntrys     *ListEntryService
-	Persons        *PersonService
-	PersonMugshots *PersonMugshotService
-	PersonWebsites *PersonWebsiteService
-	Rates          *RateService
-	Reviews        *ReviewService
-	ReviewVideos   *ReviewVideoService
-	SocialMetrics  *SocialMetricService
-	TestDummies    *TestDummyService
+	Credits        CreditReader
+	FeedFollows    FeedFollowReader
+	Follows        FollowReader
+	Lists          ListReader
+	ListEntrys     ListEntryReader
+	Persons        PersonReader
+	PersonMugshots PersonMugshotReader
+	PersonWebsites PersonWebsiteReader
+	Rates          RateReader
+	Reviews        ReviewReader
+	ReviewVideos   ReviewVideoReader
+	SocialMetrics  SocialMetricReader
+	TestDummies    TestDummyReader
 }
 
 // NewClient returns a new Client configured to communicate with the IGDB.
@@ -106,8 +113,11 @@ type Client struct {
 // requests to the IGDB. If no HTTP Client is provided, a default HTTP client
 // is used instead.
 //
+// Provide ClientOptions such as WithMiddleware or WithTracer to further
+// configure the Client.
+//
 // If you need an IGDB API key, please visit: https://api.igdb.com/signup
-func NewClient(apiKey string, custom *http.Client) *Client {
+func NewClient(apiKey string, custom *http.Client, opts ...ClientOption) *Client {
 	if custom == nil {
 		custom = http.DefaultClient
 	}
@@ -182,9 +192,30 @@ func NewClient(apiKey string, custom *http.Client) *Client {
 	c.ReviewVideos = &ReviewVideoService{client: c, end: EndpointReviewVideo}
 	c.SocialMetrics = &SocialMetricService{client: c, end: EndpointSocialMetric}
 	c.TestDummies = &TestDummyService{client: c, end: EndpointTestDummy}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.tracer != nil || c.meter != nil {
+		c.middleware = append([]Middleware{Instrument(c.tracer, c.meter)}, c.middleware...)
+	}
+
 	return c
 }
 
+// ClientOption functions are used to configure a Client when it is created
+// by NewClient.
+type ClientOption func(c *Client)
+
+// WithMiddleware is a ClientOption that adds the provided middleware to the
+// Client's middleware chain, as if passed to Use.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.Use(mw...)
+	}
+}
+
 // Request configures a new request for the provided URL and
 // adds the necessary headers to communicate with the IGDB.
 func (c *Client) request(end endpoint, opts ...Option) (*http.Request, error) {
@@ -207,26 +238,40 @@ func (c *Client) request(end endpoint, opts ...Option) (*http.Request, error) {
 // Send sends the provided request and stores the response in the value pointed to by result.
 // The response will be checked and return any errors.
 func (c *Client) send(req *http.Request, result interface{}) error {
+	_, err := c.sendResponse(req, result)
+	return err
+}
+
+// sendResponse behaves like send but also returns the HTTP response, whose
+// body has already been consumed. The response is nil if none was received.
+func (c *Client) sendResponse(req *http.Request, result interface{}) (*http.Response, error) {
 	resp, err := c.http.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "http client cannot send request")
+		return nil, errors.Wrap(err, "http client cannot send request")
 	}
 	defer resp.Body.Close()
 
 	if err = checkResponse(resp); err != nil {
-		return err
+		return resp, err
 	}
 
 	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "cannot read response body")
+		return resp, errors.Wrap(err, "cannot read response body")
 	}
+	resp.ContentLength = int64(len(b))
+
+	return resp, decodeResponse(b, result)
+}
 
+// decodeResponse decodes the provided response body into the value pointed
+// to by result.
+func decodeResponse(b []byte, result interface{}) error {
 	if isBracketPair(b) {
 		return ErrNoResults
 	}
 
-	err = json.Unmarshal(b, &result)
+	err := json.Unmarshal(b, &result)
 	if err != nil {
 		return errors.Wrap(errInvalidJSON, err.Error())
 	}
@@ -237,17 +282,55 @@ func (c *Client) send(req *http.Request, result interface{}) error {
 // Get sends a GET request to the provided endpoint with the provided options and
 // stores the results in the value pointed to by result.
 func (c *Client) get(end endpoint, result interface{}, opts ...Option) error {
+	err := c.do(end, result, opts...)
+	if err != nil {
+		return errors.Wrap(err, "cannot make GET request")
+	}
+
+	return nil
+}
+
+// do configures a request for the provided endpoint and options and passes
+// it through the Client's middleware to be sent. The response is stored in
+// the value pointed to by result.
+func (c *Client) do(end endpoint, result interface{}, opts ...Option) error {
 	req, err := c.request(end, opts...)
 	if err != nil {
 		return err
 	}
 
-	err = c.send(req, result)
-	if err != nil {
-		return errors.Wrap(err, "cannot make GET request")
+	var qry []byte
+	if req.Body != nil {
+		if qry, err = ioutil.ReadAll(req.Body); err != nil {
+			return errors.Wrap(err, "cannot read request query")
+		}
+		req.Body.Close()
 	}
 
-	return nil
+	call := &Call{
+		Endpoint: end,
+		Query:    string(qry),
+		Request:  req,
+		Result:   result,
+	}
+
+	return Chain(c.middleware...)(c.sendCall)(call)
+}
+
+// sendCall is the innermost Handler of the middleware chain. It sends the
+// Call's request with the Call's query as its body.
+func (c *Client) sendCall(call *Call) error {
+	call.Request.Body = ioutil.NopCloser(strings.NewReader(call.Query))
+	call.Request.ContentLength = int64(len(call.Query))
+	call.Attempts++
+
+	resp, err := c.sendResponse(call.Request, call.Result)
+	if resp != nil {
+		call.StatusCode = resp.StatusCode
+		call.BytesReceived += resp.ContentLength
+	}
+
+	return err
 }
 
 // GetMaxLimit returns the maximum request limit for the account type
@@ -0,0 +1,15 @@
+// Package igdbmock provides mock implementations of the igdb Reader
+// interfaces for use in tests.
+//
+// Each mock holds a function field for every method of its interface. Set
+// the fields a test needs and assign the mock to the matching Client field:
+//
+//	c := igdb.NewClient("key", nil)
+//	c.Games = &igdbmock.GameReader{
+//		GetFunc: func(id int, opts ...igdb.Option) (*igdb.Game, error) {
+//			return &igdb.Game{BaseEntity: igdb.BaseEntity{ID: id}}, nil
+//		},
+//	}
+//
+// The mocks are generated from the Reader interfaces by go generate.
+package igdbmock
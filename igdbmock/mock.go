@@ -0,0 +1,3344 @@
+// Code generated by genmock from reader.go. DO NOT EDIT.
+
+package igdbmock
+
+import "github.com/gotomgo/igdb"
+
+// AchievementReader is a mock implementation of igdb.AchievementReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type AchievementReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Achievement, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Achievement, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *AchievementReader) Get(id int, opts ...igdb.Option) (*igdb.Achievement, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: AchievementReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *AchievementReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: AchievementReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *AchievementReader) Index(opts ...igdb.Option) ([]*igdb.Achievement, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: AchievementReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *AchievementReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: AchievementReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *AchievementReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: AchievementReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.AchievementReader = (*AchievementReader)(nil)
+
+// AchievementIconReader is a mock implementation of igdb.AchievementIconReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type AchievementIconReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.AchievementIcon, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.AchievementIcon, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *AchievementIconReader) Get(id int, opts ...igdb.Option) (*igdb.AchievementIcon, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: AchievementIconReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *AchievementIconReader) List(ids []int, opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: AchievementIconReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *AchievementIconReader) Index(opts ...igdb.Option) ([]*igdb.AchievementIcon, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: AchievementIconReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *AchievementIconReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: AchievementIconReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *AchievementIconReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: AchievementIconReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.AchievementIconReader = (*AchievementIconReader)(nil)
+
+// AgeRatingReader is a mock implementation of igdb.AgeRatingReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type AgeRatingReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.AgeRating, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.AgeRating, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *AgeRatingReader) Get(id int, opts ...igdb.Option) (*igdb.AgeRating, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: AgeRatingReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *AgeRatingReader) List(ids []int, opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: AgeRatingReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *AgeRatingReader) Index(opts ...igdb.Option) ([]*igdb.AgeRating, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: AgeRatingReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *AgeRatingReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: AgeRatingReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *AgeRatingReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: AgeRatingReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.AgeRatingReader = (*AgeRatingReader)(nil)
+
+// AgeRatingContentReader is a mock implementation of igdb.AgeRatingContentReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type AgeRatingContentReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.AgeRatingContent, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *AgeRatingContentReader) Get(id int, opts ...igdb.Option) (*igdb.AgeRatingContent, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: AgeRatingContentReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *AgeRatingContentReader) List(ids []int, opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: AgeRatingContentReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *AgeRatingContentReader) Index(opts ...igdb.Option) ([]*igdb.AgeRatingContent, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: AgeRatingContentReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *AgeRatingContentReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: AgeRatingContentReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *AgeRatingContentReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: AgeRatingContentReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.AgeRatingContentReader = (*AgeRatingContentReader)(nil)
+
+// AlternativeNameReader is a mock implementation of igdb.AlternativeNameReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type AlternativeNameReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.AlternativeName, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.AlternativeName, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *AlternativeNameReader) Get(id int, opts ...igdb.Option) (*igdb.AlternativeName, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: AlternativeNameReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *AlternativeNameReader) List(ids []int, opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: AlternativeNameReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *AlternativeNameReader) Index(opts ...igdb.Option) ([]*igdb.AlternativeName, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: AlternativeNameReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *AlternativeNameReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: AlternativeNameReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *AlternativeNameReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: AlternativeNameReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.AlternativeNameReader = (*AlternativeNameReader)(nil)
+
+// ArtworkReader is a mock implementation of igdb.ArtworkReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ArtworkReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Artwork, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Artwork, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ArtworkReader) Get(id int, opts ...igdb.Option) (*igdb.Artwork, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ArtworkReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ArtworkReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ArtworkReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ArtworkReader) Index(opts ...igdb.Option) ([]*igdb.Artwork, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ArtworkReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ArtworkReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ArtworkReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ArtworkReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ArtworkReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ArtworkReader = (*ArtworkReader)(nil)
+
+// CharacterReader is a mock implementation of igdb.CharacterReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CharacterReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Character, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Character, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Character, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Character, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CharacterReader) Get(id int, opts ...igdb.Option) (*igdb.Character, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CharacterReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CharacterReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Character, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CharacterReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CharacterReader) Index(opts ...igdb.Option) ([]*igdb.Character, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CharacterReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *CharacterReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Character, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: CharacterReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *CharacterReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CharacterReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CharacterReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CharacterReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CharacterReader = (*CharacterReader)(nil)
+
+// CharacterMugshotReader is a mock implementation of igdb.CharacterMugshotReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CharacterMugshotReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.CharacterMugshot, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CharacterMugshotReader) Get(id int, opts ...igdb.Option) (*igdb.CharacterMugshot, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CharacterMugshotReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CharacterMugshotReader) List(ids []int, opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CharacterMugshotReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CharacterMugshotReader) Index(opts ...igdb.Option) ([]*igdb.CharacterMugshot, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CharacterMugshotReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CharacterMugshotReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CharacterMugshotReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CharacterMugshotReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CharacterMugshotReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CharacterMugshotReader = (*CharacterMugshotReader)(nil)
+
+// CollectionReader is a mock implementation of igdb.CollectionReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CollectionReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Collection, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Collection, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Collection, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Collection, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CollectionReader) Get(id int, opts ...igdb.Option) (*igdb.Collection, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CollectionReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CollectionReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CollectionReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CollectionReader) Index(opts ...igdb.Option) ([]*igdb.Collection, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CollectionReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *CollectionReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Collection, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: CollectionReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *CollectionReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CollectionReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CollectionReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CollectionReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CollectionReader = (*CollectionReader)(nil)
+
+// CompanyReader is a mock implementation of igdb.CompanyReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CompanyReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Company, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Company, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Company, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CompanyReader) Get(id int, opts ...igdb.Option) (*igdb.Company, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CompanyReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CompanyReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Company, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CompanyReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CompanyReader) Index(opts ...igdb.Option) ([]*igdb.Company, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CompanyReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CompanyReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CompanyReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CompanyReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CompanyReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CompanyReader = (*CompanyReader)(nil)
+
+// CompanyLogoReader is a mock implementation of igdb.CompanyLogoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CompanyLogoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.CompanyLogo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.CompanyLogo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CompanyLogoReader) Get(id int, opts ...igdb.Option) (*igdb.CompanyLogo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CompanyLogoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CompanyLogoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CompanyLogoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CompanyLogoReader) Index(opts ...igdb.Option) ([]*igdb.CompanyLogo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CompanyLogoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CompanyLogoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CompanyLogoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CompanyLogoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CompanyLogoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CompanyLogoReader = (*CompanyLogoReader)(nil)
+
+// CompanyWebsiteReader is a mock implementation of igdb.CompanyWebsiteReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CompanyWebsiteReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.CompanyWebsite, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CompanyWebsiteReader) Get(id int, opts ...igdb.Option) (*igdb.CompanyWebsite, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CompanyWebsiteReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CompanyWebsiteReader) List(ids []int, opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CompanyWebsiteReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CompanyWebsiteReader) Index(opts ...igdb.Option) ([]*igdb.CompanyWebsite, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CompanyWebsiteReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CompanyWebsiteReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CompanyWebsiteReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CompanyWebsiteReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CompanyWebsiteReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CompanyWebsiteReader = (*CompanyWebsiteReader)(nil)
+
+// CoverReader is a mock implementation of igdb.CoverReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CoverReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Cover, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Cover, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Cover, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CoverReader) Get(id int, opts ...igdb.Option) (*igdb.Cover, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CoverReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CoverReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Cover, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CoverReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CoverReader) Index(opts ...igdb.Option) ([]*igdb.Cover, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CoverReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CoverReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CoverReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CoverReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CoverReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CoverReader = (*CoverReader)(nil)
+
+// ExternalGameReader is a mock implementation of igdb.ExternalGameReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ExternalGameReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.ExternalGame, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.ExternalGame, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ExternalGameReader) Get(id int, opts ...igdb.Option) (*igdb.ExternalGame, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ExternalGameReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ExternalGameReader) List(ids []int, opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ExternalGameReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ExternalGameReader) Index(opts ...igdb.Option) ([]*igdb.ExternalGame, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ExternalGameReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ExternalGameReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ExternalGameReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ExternalGameReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ExternalGameReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ExternalGameReader = (*ExternalGameReader)(nil)
+
+// FeedReader is a mock implementation of igdb.FeedReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type FeedReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Feed, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Feed, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Feed, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *FeedReader) Get(id int, opts ...igdb.Option) (*igdb.Feed, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: FeedReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *FeedReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Feed, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: FeedReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *FeedReader) Index(opts ...igdb.Option) ([]*igdb.Feed, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: FeedReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *FeedReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: FeedReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *FeedReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: FeedReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.FeedReader = (*FeedReader)(nil)
+
+// FranchiseReader is a mock implementation of igdb.FranchiseReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type FranchiseReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Franchise, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Franchise, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *FranchiseReader) Get(id int, opts ...igdb.Option) (*igdb.Franchise, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: FranchiseReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *FranchiseReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: FranchiseReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *FranchiseReader) Index(opts ...igdb.Option) ([]*igdb.Franchise, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: FranchiseReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *FranchiseReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: FranchiseReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *FranchiseReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: FranchiseReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.FranchiseReader = (*FranchiseReader)(nil)
+
+// GameReader is a mock implementation of igdb.GameReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Game, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Game, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Game, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Game, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameReader) Get(id int, opts ...igdb.Option) (*igdb.Game, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Game, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameReader) Index(opts ...igdb.Option) ([]*igdb.Game, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *GameReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Game, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: GameReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameReader = (*GameReader)(nil)
+
+// GameEngineReader is a mock implementation of igdb.GameEngineReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameEngineReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameEngine, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameEngine, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameEngineReader) Get(id int, opts ...igdb.Option) (*igdb.GameEngine, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameEngineReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameEngineReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameEngineReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameEngineReader) Index(opts ...igdb.Option) ([]*igdb.GameEngine, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameEngineReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameEngineReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameEngineReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameEngineReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameEngineReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameEngineReader = (*GameEngineReader)(nil)
+
+// GameEngineLogoReader is a mock implementation of igdb.GameEngineLogoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameEngineLogoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameEngineLogo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameEngineLogoReader) Get(id int, opts ...igdb.Option) (*igdb.GameEngineLogo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameEngineLogoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameEngineLogoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameEngineLogoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameEngineLogoReader) Index(opts ...igdb.Option) ([]*igdb.GameEngineLogo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameEngineLogoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameEngineLogoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameEngineLogoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameEngineLogoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameEngineLogoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameEngineLogoReader = (*GameEngineLogoReader)(nil)
+
+// GameModeReader is a mock implementation of igdb.GameModeReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameModeReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameMode, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameMode, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameModeReader) Get(id int, opts ...igdb.Option) (*igdb.GameMode, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameModeReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameModeReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameModeReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameModeReader) Index(opts ...igdb.Option) ([]*igdb.GameMode, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameModeReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameModeReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameModeReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameModeReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameModeReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameModeReader = (*GameModeReader)(nil)
+
+// GameVersionReader is a mock implementation of igdb.GameVersionReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameVersionReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameVersion, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameVersion, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameVersionReader) Get(id int, opts ...igdb.Option) (*igdb.GameVersion, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameVersionReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameVersionReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameVersionReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameVersionReader) Index(opts ...igdb.Option) ([]*igdb.GameVersion, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameVersionReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameVersionReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameVersionReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameVersionReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameVersionReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameVersionReader = (*GameVersionReader)(nil)
+
+// GameVersionFeatureReader is a mock implementation of igdb.GameVersionFeatureReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameVersionFeatureReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameVersionFeature, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameVersionFeatureReader) Get(id int, opts ...igdb.Option) (*igdb.GameVersionFeature, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameVersionFeatureReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameVersionFeatureReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameVersionFeatureReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameVersionFeatureReader) Index(opts ...igdb.Option) ([]*igdb.GameVersionFeature, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameVersionFeatureReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameVersionFeatureReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameVersionFeatureReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameVersionFeatureReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameVersionFeatureReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameVersionFeatureReader = (*GameVersionFeatureReader)(nil)
+
+// GameVersionFeatureValueReader is a mock implementation of igdb.GameVersionFeatureValueReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameVersionFeatureValueReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameVersionFeatureValueReader) Get(id int, opts ...igdb.Option) (*igdb.GameVersionFeatureValue, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameVersionFeatureValueReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameVersionFeatureValueReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameVersionFeatureValueReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameVersionFeatureValueReader) Index(opts ...igdb.Option) ([]*igdb.GameVersionFeatureValue, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameVersionFeatureValueReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameVersionFeatureValueReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameVersionFeatureValueReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameVersionFeatureValueReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameVersionFeatureValueReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameVersionFeatureValueReader = (*GameVersionFeatureValueReader)(nil)
+
+// GameVideoReader is a mock implementation of igdb.GameVideoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GameVideoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.GameVideo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.GameVideo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GameVideoReader) Get(id int, opts ...igdb.Option) (*igdb.GameVideo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GameVideoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GameVideoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GameVideoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GameVideoReader) Index(opts ...igdb.Option) ([]*igdb.GameVideo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GameVideoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GameVideoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GameVideoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GameVideoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GameVideoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GameVideoReader = (*GameVideoReader)(nil)
+
+// GenreReader is a mock implementation of igdb.GenreReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type GenreReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Genre, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Genre, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Genre, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *GenreReader) Get(id int, opts ...igdb.Option) (*igdb.Genre, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: GenreReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *GenreReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Genre, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: GenreReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *GenreReader) Index(opts ...igdb.Option) ([]*igdb.Genre, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: GenreReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *GenreReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: GenreReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *GenreReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: GenreReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.GenreReader = (*GenreReader)(nil)
+
+// InvolvedCompanyReader is a mock implementation of igdb.InvolvedCompanyReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type InvolvedCompanyReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.InvolvedCompany, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *InvolvedCompanyReader) Get(id int, opts ...igdb.Option) (*igdb.InvolvedCompany, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: InvolvedCompanyReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *InvolvedCompanyReader) List(ids []int, opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: InvolvedCompanyReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *InvolvedCompanyReader) Index(opts ...igdb.Option) ([]*igdb.InvolvedCompany, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: InvolvedCompanyReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *InvolvedCompanyReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: InvolvedCompanyReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *InvolvedCompanyReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: InvolvedCompanyReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.InvolvedCompanyReader = (*InvolvedCompanyReader)(nil)
+
+// KeywordReader is a mock implementation of igdb.KeywordReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type KeywordReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Keyword, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Keyword, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *KeywordReader) Get(id int, opts ...igdb.Option) (*igdb.Keyword, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: KeywordReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *KeywordReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: KeywordReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *KeywordReader) Index(opts ...igdb.Option) ([]*igdb.Keyword, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: KeywordReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *KeywordReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: KeywordReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *KeywordReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: KeywordReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.KeywordReader = (*KeywordReader)(nil)
+
+// MultiplayerModeReader is a mock implementation of igdb.MultiplayerModeReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type MultiplayerModeReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.MultiplayerMode, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *MultiplayerModeReader) Get(id int, opts ...igdb.Option) (*igdb.MultiplayerMode, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: MultiplayerModeReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *MultiplayerModeReader) List(ids []int, opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: MultiplayerModeReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *MultiplayerModeReader) Index(opts ...igdb.Option) ([]*igdb.MultiplayerMode, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: MultiplayerModeReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *MultiplayerModeReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: MultiplayerModeReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *MultiplayerModeReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: MultiplayerModeReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.MultiplayerModeReader = (*MultiplayerModeReader)(nil)
+
+// PageReader is a mock implementation of igdb.PageReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PageReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Page, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Page, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Page, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PageReader) Get(id int, opts ...igdb.Option) (*igdb.Page, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PageReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PageReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Page, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PageReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PageReader) Index(opts ...igdb.Option) ([]*igdb.Page, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PageReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PageReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PageReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PageReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PageReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PageReader = (*PageReader)(nil)
+
+// PageBackgroundReader is a mock implementation of igdb.PageBackgroundReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PageBackgroundReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PageBackground, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PageBackground, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PageBackgroundReader) Get(id int, opts ...igdb.Option) (*igdb.PageBackground, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PageBackgroundReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PageBackgroundReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PageBackgroundReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PageBackgroundReader) Index(opts ...igdb.Option) ([]*igdb.PageBackground, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PageBackgroundReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PageBackgroundReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PageBackgroundReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PageBackgroundReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PageBackgroundReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PageBackgroundReader = (*PageBackgroundReader)(nil)
+
+// PageLogoReader is a mock implementation of igdb.PageLogoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PageLogoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PageLogo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PageLogo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PageLogoReader) Get(id int, opts ...igdb.Option) (*igdb.PageLogo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PageLogoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PageLogoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PageLogoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PageLogoReader) Index(opts ...igdb.Option) ([]*igdb.PageLogo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PageLogoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PageLogoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PageLogoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PageLogoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PageLogoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PageLogoReader = (*PageLogoReader)(nil)
+
+// PageWebsiteReader is a mock implementation of igdb.PageWebsiteReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PageWebsiteReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PageWebsite, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PageWebsite, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PageWebsiteReader) Get(id int, opts ...igdb.Option) (*igdb.PageWebsite, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PageWebsiteReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PageWebsiteReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PageWebsiteReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PageWebsiteReader) Index(opts ...igdb.Option) ([]*igdb.PageWebsite, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PageWebsiteReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PageWebsiteReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PageWebsiteReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PageWebsiteReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PageWebsiteReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PageWebsiteReader = (*PageWebsiteReader)(nil)
+
+// PlatformReader is a mock implementation of igdb.PlatformReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Platform, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Platform, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Platform, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Platform, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformReader) Get(id int, opts ...igdb.Option) (*igdb.Platform, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformReader) Index(opts ...igdb.Option) ([]*igdb.Platform, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *PlatformReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Platform, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: PlatformReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformReader = (*PlatformReader)(nil)
+
+// PlatformLogoReader is a mock implementation of igdb.PlatformLogoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformLogoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlatformLogo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlatformLogo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformLogoReader) Get(id int, opts ...igdb.Option) (*igdb.PlatformLogo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformLogoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformLogoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformLogoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformLogoReader) Index(opts ...igdb.Option) ([]*igdb.PlatformLogo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformLogoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformLogoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformLogoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformLogoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformLogoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformLogoReader = (*PlatformLogoReader)(nil)
+
+// PlatformVersionReader is a mock implementation of igdb.PlatformVersionReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformVersionReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlatformVersion, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlatformVersion, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformVersionReader) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersion, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformVersionReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformVersionReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformVersionReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformVersionReader) Index(opts ...igdb.Option) ([]*igdb.PlatformVersion, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformVersionReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformVersionReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformVersionReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformVersionReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformVersionReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformVersionReader = (*PlatformVersionReader)(nil)
+
+// PlatformVersionCompanyReader is a mock implementation of igdb.PlatformVersionCompanyReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformVersionCompanyReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformVersionCompanyReader) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersionCompany, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformVersionCompanyReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformVersionCompanyReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformVersionCompanyReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformVersionCompanyReader) Index(opts ...igdb.Option) ([]*igdb.PlatformVersionCompany, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformVersionCompanyReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformVersionCompanyReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformVersionCompanyReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformVersionCompanyReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformVersionCompanyReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformVersionCompanyReader = (*PlatformVersionCompanyReader)(nil)
+
+// PlatformVersionReleaseDateReader is a mock implementation of igdb.PlatformVersionReleaseDateReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformVersionReleaseDateReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformVersionReleaseDateReader) Get(id int, opts ...igdb.Option) (*igdb.PlatformVersionReleaseDate, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformVersionReleaseDateReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformVersionReleaseDateReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformVersionReleaseDateReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformVersionReleaseDateReader) Index(opts ...igdb.Option) ([]*igdb.PlatformVersionReleaseDate, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformVersionReleaseDateReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformVersionReleaseDateReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformVersionReleaseDateReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformVersionReleaseDateReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformVersionReleaseDateReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformVersionReleaseDateReader = (*PlatformVersionReleaseDateReader)(nil)
+
+// PlatformWebsiteReader is a mock implementation of igdb.PlatformWebsiteReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlatformWebsiteReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlatformWebsite, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlatformWebsiteReader) Get(id int, opts ...igdb.Option) (*igdb.PlatformWebsite, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlatformWebsiteReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlatformWebsiteReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlatformWebsiteReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlatformWebsiteReader) Index(opts ...igdb.Option) ([]*igdb.PlatformWebsite, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlatformWebsiteReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlatformWebsiteReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlatformWebsiteReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlatformWebsiteReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlatformWebsiteReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlatformWebsiteReader = (*PlatformWebsiteReader)(nil)
+
+// PlayerPerspectiveReader is a mock implementation of igdb.PlayerPerspectiveReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PlayerPerspectiveReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PlayerPerspective, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PlayerPerspectiveReader) Get(id int, opts ...igdb.Option) (*igdb.PlayerPerspective, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PlayerPerspectiveReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PlayerPerspectiveReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PlayerPerspectiveReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PlayerPerspectiveReader) Index(opts ...igdb.Option) ([]*igdb.PlayerPerspective, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PlayerPerspectiveReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PlayerPerspectiveReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PlayerPerspectiveReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PlayerPerspectiveReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PlayerPerspectiveReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PlayerPerspectiveReader = (*PlayerPerspectiveReader)(nil)
+
+// ProductFamilyReader is a mock implementation of igdb.ProductFamilyReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ProductFamilyReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.ProductFamily, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.ProductFamily, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ProductFamilyReader) Get(id int, opts ...igdb.Option) (*igdb.ProductFamily, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ProductFamilyReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ProductFamilyReader) List(ids []int, opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ProductFamilyReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ProductFamilyReader) Index(opts ...igdb.Option) ([]*igdb.ProductFamily, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ProductFamilyReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ProductFamilyReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ProductFamilyReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ProductFamilyReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ProductFamilyReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ProductFamilyReader = (*ProductFamilyReader)(nil)
+
+// PulseReader is a mock implementation of igdb.PulseReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PulseReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Pulse, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Pulse, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PulseReader) Get(id int, opts ...igdb.Option) (*igdb.Pulse, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PulseReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PulseReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PulseReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PulseReader) Index(opts ...igdb.Option) ([]*igdb.Pulse, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PulseReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PulseReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PulseReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PulseReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PulseReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PulseReader = (*PulseReader)(nil)
+
+// PulseGroupReader is a mock implementation of igdb.PulseGroupReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PulseGroupReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PulseGroup, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PulseGroup, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PulseGroupReader) Get(id int, opts ...igdb.Option) (*igdb.PulseGroup, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PulseGroupReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PulseGroupReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PulseGroupReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PulseGroupReader) Index(opts ...igdb.Option) ([]*igdb.PulseGroup, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PulseGroupReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PulseGroupReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PulseGroupReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PulseGroupReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PulseGroupReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PulseGroupReader = (*PulseGroupReader)(nil)
+
+// PulseSourceReader is a mock implementation of igdb.PulseSourceReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PulseSourceReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PulseSource, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PulseSource, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PulseSourceReader) Get(id int, opts ...igdb.Option) (*igdb.PulseSource, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PulseSourceReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PulseSourceReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PulseSourceReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PulseSourceReader) Index(opts ...igdb.Option) ([]*igdb.PulseSource, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PulseSourceReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PulseSourceReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PulseSourceReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PulseSourceReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PulseSourceReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PulseSourceReader = (*PulseSourceReader)(nil)
+
+// PulseURLReader is a mock implementation of igdb.PulseURLReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PulseURLReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PulseURL, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PulseURLReader) Get(id int, opts ...igdb.Option) (*igdb.PulseURL, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PulseURLReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PulseURLReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PulseURLReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PulseURLReader) Index(opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PulseURLReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *PulseURLReader) Search(qry string, opts ...igdb.Option) ([]*igdb.PulseURL, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: PulseURLReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *PulseURLReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PulseURLReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PulseURLReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PulseURLReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PulseURLReader = (*PulseURLReader)(nil)
+
+// ReleaseDateReader is a mock implementation of igdb.ReleaseDateReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ReleaseDateReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.ReleaseDate, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.ReleaseDate, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ReleaseDateReader) Get(id int, opts ...igdb.Option) (*igdb.ReleaseDate, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ReleaseDateReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ReleaseDateReader) List(ids []int, opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ReleaseDateReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ReleaseDateReader) Index(opts ...igdb.Option) ([]*igdb.ReleaseDate, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ReleaseDateReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ReleaseDateReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ReleaseDateReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ReleaseDateReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ReleaseDateReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ReleaseDateReader = (*ReleaseDateReader)(nil)
+
+// ScreenshotReader is a mock implementation of igdb.ScreenshotReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ScreenshotReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Screenshot, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Screenshot, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ScreenshotReader) Get(id int, opts ...igdb.Option) (*igdb.Screenshot, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ScreenshotReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ScreenshotReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ScreenshotReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ScreenshotReader) Index(opts ...igdb.Option) ([]*igdb.Screenshot, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ScreenshotReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ScreenshotReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ScreenshotReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ScreenshotReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ScreenshotReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ScreenshotReader = (*ScreenshotReader)(nil)
+
+// ThemeReader is a mock implementation of igdb.ThemeReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ThemeReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Theme, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Theme, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Theme, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Theme, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ThemeReader) Get(id int, opts ...igdb.Option) (*igdb.Theme, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ThemeReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ThemeReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ThemeReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ThemeReader) Index(opts ...igdb.Option) ([]*igdb.Theme, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ThemeReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *ThemeReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Theme, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: ThemeReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *ThemeReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ThemeReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ThemeReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ThemeReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ThemeReader = (*ThemeReader)(nil)
+
+// TimeToBeatReader is a mock implementation of igdb.TimeToBeatReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type TimeToBeatReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.TimeToBeat, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.TimeToBeat, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *TimeToBeatReader) Get(id int, opts ...igdb.Option) (*igdb.TimeToBeat, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: TimeToBeatReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *TimeToBeatReader) List(ids []int, opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: TimeToBeatReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *TimeToBeatReader) Index(opts ...igdb.Option) ([]*igdb.TimeToBeat, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: TimeToBeatReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *TimeToBeatReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: TimeToBeatReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *TimeToBeatReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: TimeToBeatReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.TimeToBeatReader = (*TimeToBeatReader)(nil)
+
+// TitleReader is a mock implementation of igdb.TitleReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type TitleReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Title, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Title, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Title, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *TitleReader) Get(id int, opts ...igdb.Option) (*igdb.Title, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: TitleReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *TitleReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Title, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: TitleReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *TitleReader) Index(opts ...igdb.Option) ([]*igdb.Title, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: TitleReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *TitleReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: TitleReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *TitleReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: TitleReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.TitleReader = (*TitleReader)(nil)
+
+// WebsiteReader is a mock implementation of igdb.WebsiteReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type WebsiteReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Website, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Website, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Website, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *WebsiteReader) Get(id int, opts ...igdb.Option) (*igdb.Website, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: WebsiteReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *WebsiteReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Website, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: WebsiteReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *WebsiteReader) Index(opts ...igdb.Option) ([]*igdb.Website, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: WebsiteReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *WebsiteReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: WebsiteReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *WebsiteReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: WebsiteReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.WebsiteReader = (*WebsiteReader)(nil)
+
+// CreditReader is a mock implementation of igdb.CreditReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type CreditReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Credit, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Credit, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Credit, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *CreditReader) Get(id int, opts ...igdb.Option) (*igdb.Credit, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: CreditReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *CreditReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Credit, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: CreditReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *CreditReader) Index(opts ...igdb.Option) ([]*igdb.Credit, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: CreditReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *CreditReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: CreditReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *CreditReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: CreditReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.CreditReader = (*CreditReader)(nil)
+
+// FeedFollowReader is a mock implementation of igdb.FeedFollowReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type FeedFollowReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.FeedFollow, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.FeedFollow, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *FeedFollowReader) Get(id int, opts ...igdb.Option) (*igdb.FeedFollow, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: FeedFollowReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *FeedFollowReader) List(ids []int, opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: FeedFollowReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *FeedFollowReader) Index(opts ...igdb.Option) ([]*igdb.FeedFollow, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: FeedFollowReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *FeedFollowReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: FeedFollowReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *FeedFollowReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: FeedFollowReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.FeedFollowReader = (*FeedFollowReader)(nil)
+
+// FollowReader is a mock implementation of igdb.FollowReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type FollowReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Follow, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Follow, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Follow, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *FollowReader) Get(id int, opts ...igdb.Option) (*igdb.Follow, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: FollowReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *FollowReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Follow, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: FollowReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *FollowReader) Index(opts ...igdb.Option) ([]*igdb.Follow, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: FollowReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *FollowReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: FollowReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *FollowReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: FollowReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.FollowReader = (*FollowReader)(nil)
+
+// ListReader is a mock implementation of igdb.ListReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ListReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.List, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.List, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.List, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ListReader) Get(id int, opts ...igdb.Option) (*igdb.List, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ListReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ListReader) List(ids []int, opts ...igdb.Option) ([]*igdb.List, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ListReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ListReader) Index(opts ...igdb.Option) ([]*igdb.List, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ListReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ListReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ListReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ListReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ListReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ListReader = (*ListReader)(nil)
+
+// ListEntryReader is a mock implementation of igdb.ListEntryReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ListEntryReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.ListEntry, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.ListEntry, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ListEntryReader) Get(id int, opts ...igdb.Option) (*igdb.ListEntry, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ListEntryReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ListEntryReader) List(ids []int, opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ListEntryReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ListEntryReader) Index(opts ...igdb.Option) ([]*igdb.ListEntry, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ListEntryReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ListEntryReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ListEntryReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ListEntryReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ListEntryReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ListEntryReader = (*ListEntryReader)(nil)
+
+// PersonReader is a mock implementation of igdb.PersonReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PersonReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Person, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Person, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Person, error)
+	SearchFunc func(qry string, opts ...igdb.Option) ([]*igdb.Person, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PersonReader) Get(id int, opts ...igdb.Option) (*igdb.Person, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PersonReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PersonReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Person, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PersonReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PersonReader) Index(opts ...igdb.Option) ([]*igdb.Person, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PersonReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Search calls SearchFunc.
+func (m *PersonReader) Search(qry string, opts ...igdb.Option) ([]*igdb.Person, error) {
+	if m.SearchFunc == nil {
+		panic("igdbmock: PersonReader.Search called with nil SearchFunc")
+	}
+	return m.SearchFunc(qry, opts...)
+}
+
+// Count calls CountFunc.
+func (m *PersonReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PersonReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PersonReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PersonReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PersonReader = (*PersonReader)(nil)
+
+// PersonMugshotReader is a mock implementation of igdb.PersonMugshotReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PersonMugshotReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PersonMugshot, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PersonMugshot, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PersonMugshotReader) Get(id int, opts ...igdb.Option) (*igdb.PersonMugshot, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PersonMugshotReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PersonMugshotReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PersonMugshotReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PersonMugshotReader) Index(opts ...igdb.Option) ([]*igdb.PersonMugshot, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PersonMugshotReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PersonMugshotReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PersonMugshotReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PersonMugshotReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PersonMugshotReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PersonMugshotReader = (*PersonMugshotReader)(nil)
+
+// PersonWebsiteReader is a mock implementation of igdb.PersonWebsiteReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type PersonWebsiteReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.PersonWebsite, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.PersonWebsite, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *PersonWebsiteReader) Get(id int, opts ...igdb.Option) (*igdb.PersonWebsite, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: PersonWebsiteReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *PersonWebsiteReader) List(ids []int, opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: PersonWebsiteReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *PersonWebsiteReader) Index(opts ...igdb.Option) ([]*igdb.PersonWebsite, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: PersonWebsiteReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *PersonWebsiteReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: PersonWebsiteReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *PersonWebsiteReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: PersonWebsiteReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.PersonWebsiteReader = (*PersonWebsiteReader)(nil)
+
+// RateReader is a mock implementation of igdb.RateReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type RateReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Rate, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Rate, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Rate, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *RateReader) Get(id int, opts ...igdb.Option) (*igdb.Rate, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: RateReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *RateReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Rate, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: RateReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *RateReader) Index(opts ...igdb.Option) ([]*igdb.Rate, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: RateReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *RateReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: RateReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *RateReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: RateReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.RateReader = (*RateReader)(nil)
+
+// ReviewReader is a mock implementation of igdb.ReviewReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ReviewReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.Review, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.Review, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.Review, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ReviewReader) Get(id int, opts ...igdb.Option) (*igdb.Review, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ReviewReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ReviewReader) List(ids []int, opts ...igdb.Option) ([]*igdb.Review, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ReviewReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ReviewReader) Index(opts ...igdb.Option) ([]*igdb.Review, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ReviewReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ReviewReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ReviewReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ReviewReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ReviewReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ReviewReader = (*ReviewReader)(nil)
+
+// ReviewVideoReader is a mock implementation of igdb.ReviewVideoReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type ReviewVideoReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.ReviewVideo, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.ReviewVideo, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *ReviewVideoReader) Get(id int, opts ...igdb.Option) (*igdb.ReviewVideo, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: ReviewVideoReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *ReviewVideoReader) List(ids []int, opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: ReviewVideoReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *ReviewVideoReader) Index(opts ...igdb.Option) ([]*igdb.ReviewVideo, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: ReviewVideoReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *ReviewVideoReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: ReviewVideoReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *ReviewVideoReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: ReviewVideoReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.ReviewVideoReader = (*ReviewVideoReader)(nil)
+
+// SocialMetricReader is a mock implementation of igdb.SocialMetricReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type SocialMetricReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.SocialMetric, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.SocialMetric, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *SocialMetricReader) Get(id int, opts ...igdb.Option) (*igdb.SocialMetric, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: SocialMetricReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *SocialMetricReader) List(ids []int, opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: SocialMetricReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *SocialMetricReader) Index(opts ...igdb.Option) ([]*igdb.SocialMetric, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: SocialMetricReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *SocialMetricReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: SocialMetricReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *SocialMetricReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: SocialMetricReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.SocialMetricReader = (*SocialMetricReader)(nil)
+
+// TestDummyReader is a mock implementation of igdb.TestDummyReader. Each method calls
+// the function field of the same name and panics if it is nil.
+type TestDummyReader struct {
+	GetFunc    func(id int, opts ...igdb.Option) (*igdb.TestDummy, error)
+	ListFunc   func(ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error)
+	IndexFunc  func(opts ...igdb.Option) ([]*igdb.TestDummy, error)
+	CountFunc  func(opts ...igdb.Option) (int, error)
+	FieldsFunc func() ([]string, error)
+}
+
+// Get calls GetFunc.
+func (m *TestDummyReader) Get(id int, opts ...igdb.Option) (*igdb.TestDummy, error) {
+	if m.GetFunc == nil {
+		panic("igdbmock: TestDummyReader.Get called with nil GetFunc")
+	}
+	return m.GetFunc(id, opts...)
+}
+
+// List calls ListFunc.
+func (m *TestDummyReader) List(ids []int, opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	if m.ListFunc == nil {
+		panic("igdbmock: TestDummyReader.List called with nil ListFunc")
+	}
+	return m.ListFunc(ids, opts...)
+}
+
+// Index calls IndexFunc.
+func (m *TestDummyReader) Index(opts ...igdb.Option) ([]*igdb.TestDummy, error) {
+	if m.IndexFunc == nil {
+		panic("igdbmock: TestDummyReader.Index called with nil IndexFunc")
+	}
+	return m.IndexFunc(opts...)
+}
+
+// Count calls CountFunc.
+func (m *TestDummyReader) Count(opts ...igdb.Option) (int, error) {
+	if m.CountFunc == nil {
+		panic("igdbmock: TestDummyReader.Count called with nil CountFunc")
+	}
+	return m.CountFunc(opts...)
+}
+
+// Fields calls FieldsFunc.
+func (m *TestDummyReader) Fields() ([]string, error) {
+	if m.FieldsFunc == nil {
+		panic("igdbmock: TestDummyReader.Fields called with nil FieldsFunc")
+	}
+	return m.FieldsFunc()
+}
+
+var _ igdb.TestDummyReader = (*TestDummyReader)(nil)
@@ -0,0 +1,43 @@
+package igdbmock
+
+import (
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/pkg/errors"
+)
+
+func TestGameReader(t *testing.T) {
+	c := igdb.NewClient("notarealkey", nil)
+	c.Games = &GameReader{
+		GetFunc: func(id int, opts ...igdb.Option) (*igdb.Game, error) {
+			if id < 0 {
+				return nil, igdb.ErrNegativeID
+			}
+			return &igdb.Game{BaseEntity: igdb.BaseEntity{ID: id}}, nil
+		},
+	}
+
+	g, err := c.Games.Get(7346)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.ID != 7346 {
+		t.Errorf("got: <%v>, want: <%v>", g.ID, 7346)
+	}
+
+	if _, err := c.Games.Get(-1); errors.Cause(err) != igdb.ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrNegativeID)
+	}
+}
+
+func TestGameReader_NilFunc(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a nil function field")
+		}
+	}()
+
+	var m GameReader
+	m.Count()
+}
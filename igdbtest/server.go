@@ -0,0 +1,342 @@
+// Package igdbtest provides an in-memory fake of the IGDB API for testing
+// code built on the igdb client.
+//
+// Unlike a canned-response test server, the fake Server evaluates the
+// apicalypse query sent with each request against the entities loaded into
+// it. The fields, exclude, where, sort, limit, offset, and search clauses are
+// honored, as are the count and meta sub-endpoints, so the results of
+// functional options such as SetFilter and SetOrder can be checked for
+// correctness. Subfields of fields declared with Reference are expanded into
+// the referenced entities.
+package igdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/internal/query"
+	"github.com/pkg/errors"
+)
+
+// Default pagination values mirrored from the IGDB API.
+const (
+	// DefaultLimit is the number of results returned when no limit is provided.
+	DefaultLimit = query.DefaultLimit
+	// DefaultMaxLimit is the largest limit accepted by a new Server.
+	DefaultMaxLimit = 500
+)
+
+// Server is a fake IGDB API server backed by in-memory fixtures. Requests
+// sent to the Server have their apicalypse queries executed against the
+// entities loaded for the requested endpoint.
+type Server struct {
+	// URL is the base URL of the Server, of the form http://ipaddr:port
+	// with no trailing slash.
+	URL string
+	// MaxLimit is the largest limit a query may request. Queries exceeding
+	// it are rejected with a bad request status.
+	MaxLimit int
+
+	srv  *httptest.Server
+	mu   sync.RWMutex
+	data map[string][]query.Entity
+	refs map[string]string
+}
+
+// NewServer starts and returns a new Server with no entities loaded.
+// The caller should call Close when finished to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		MaxLimit: DefaultMaxLimit,
+		data:     make(map[string][]query.Entity),
+		refs:     make(map[string]string),
+	}
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close shuts down the Server and blocks until all outstanding
+// requests on it have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns an HTTP client that sends every request to the Server,
+// regardless of the host in the request URL.
+func (s *Server) Client() *http.Client {
+	return &http.Client{Transport: &redirectTransport{host: strings.TrimPrefix(s.URL, "http://")}}
+}
+
+// NewClient returns an igdb Client that communicates with the Server.
+func (s *Server) NewClient() *igdb.Client {
+	return igdb.NewClient("igdbtest", s.Client())
+}
+
+// redirectTransport rewrites the scheme and host of each request to
+// point at a local test server.
+type redirectTransport struct {
+	host string
+}
+
+// RoundTrip fulfills the http.RoundTripper interface.
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = "http"
+	r.URL.Host = t.host
+	r.Host = t.host
+
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// Load decodes a JSON array of entities from the provided reader and adds
+// them to the provided endpoint (e.g. string(igdb.EndpointGame)). Entities
+// sharing an ID with an existing entity replace it.
+func (s *Server) Load(end string, r io.Reader) error {
+	ents, err := query.Decode(r)
+	if err != nil {
+		return errors.Wrapf(err, "cannot decode fixtures for endpoint '%s'", end)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := endpointKey(end)
+	for _, e := range ents {
+		s.data[key] = upsert(s.data[key], e)
+	}
+
+	return nil
+}
+
+// LoadFile adds the JSON array of entities in the provided file to the
+// provided endpoint. The files in the igdb test_data directory are suitable
+// fixtures.
+func (s *Server) LoadFile(end, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return errors.Wrapf(err, "cannot open fixture file '%s'", filename)
+	}
+	defer f.Close()
+
+	return s.Load(end, f)
+}
+
+// LoadAll adds the JSON array of entities held by each value of the provided
+// map to the endpoint of its key (e.g. string(igdb.EndpointGame)). The
+// endpoints are loaded in sorted order and the first error stops the load.
+func (s *Server) LoadAll(fixtures map[string]string) error {
+	ends := make([]string, 0, len(fixtures))
+	for end := range fixtures {
+		ends = append(ends, end)
+	}
+	sort.Strings(ends)
+
+	for _, end := range ends {
+		if err := s.Load(end, strings.NewReader(fixtures[end])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add adds the provided values (e.g. *igdb.Game) to the provided endpoint.
+// Each value is encoded to JSON before being stored.
+func (s *Server) Add(end string, vals ...interface{}) error {
+	b, err := json.Marshal(vals)
+	if err != nil {
+		return errors.Wrapf(err, "cannot encode fixtures for endpoint '%s'", end)
+	}
+
+	return s.Load(end, bytes.NewReader(b))
+}
+
+// Reference declares that the provided field of the entities of the provided
+// endpoint holds the IDs of entities of the target endpoint, such as the
+// cover field of Games. Requesting subfields of a declared field (e.g.
+// cover.* or cover.url) expands its IDs into the referenced entities with
+// those subfields, as with the IGDB API. IDs matching no entity are left in
+// place.
+func (s *Server) Reference(end, field, target string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refs[endpointKey(end)+"."+field] = endpointKey(target)
+}
+
+// Reset removes every entity from every endpoint.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data = make(map[string][]query.Entity)
+}
+
+// upsert replaces the entity in ents sharing an ID with e, or appends e.
+func upsert(ents []query.Entity, e query.Entity) []query.Entity {
+	id := e.ID()
+	if id < 0 {
+		return append(ents, e)
+	}
+
+	for i, old := range ents {
+		if old.ID() == id {
+			ents[i] = e
+			return ents
+		}
+	}
+
+	return append(ents, e)
+}
+
+// endpointKey normalizes an endpoint or request path into a map key.
+func endpointKey(end string) string {
+	return strings.Trim(end, "/")
+}
+
+// ServeHTTP fulfills the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	qry, err := query.Parse(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	key := endpointKey(r.URL.Path)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch {
+	case strings.HasSuffix(key, "/count"):
+		ents := qry.Filter(s.data[strings.TrimSuffix(key, "/count")])
+		writeJSON(w, igdb.Count{Count: len(ents)})
+	case strings.HasSuffix(key, "/meta"):
+		writeJSON(w, query.FieldNames(s.data[strings.TrimSuffix(key, "/meta")]))
+	case key == endpointKey(string(igdb.EndpointStatus)):
+		stat := s.data[key]
+		if stat == nil {
+			stat = []query.Entity{}
+		}
+		writeJSON(w, stat)
+	default:
+		res, err := qry.Run(s.data[key], s.MaxLimit)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.expand(key, res, qry.Fields)
+		writeJSON(w, res)
+	}
+}
+
+// expand replaces the IDs held by the declared reference fields of the
+// provided entities with the referenced entities, projected to the
+// subfields requested of each field. The Server's lock must be held.
+func (s *Server) expand(key string, ents []query.Entity, fields []string) {
+	subs := make(map[string][]string)
+	var order []string
+	for _, f := range fields {
+		parts := strings.SplitN(f, ".", 2)
+		if len(parts) < 2 {
+			continue
+		}
+		if _, ok := subs[parts[0]]; !ok {
+			order = append(order, parts[0])
+		}
+		subs[parts[0]] = append(subs[parts[0]], parts[1])
+	}
+
+	for _, field := range order {
+		target, ok := s.refs[key+"."+field]
+		if !ok {
+			continue
+		}
+
+		var found []query.Entity
+		lookup := func(v interface{}) interface{} {
+			if ref := s.find(target, v, subs[field]); ref != nil {
+				found = append(found, ref)
+				return ref
+			}
+			return v
+		}
+
+		for _, e := range ents {
+			switch v := e[field].(type) {
+			case nil:
+			case []interface{}:
+				vals := make([]interface{}, len(v))
+				for i, id := range v {
+					vals[i] = lookup(id)
+				}
+				e[field] = vals
+			default:
+				e[field] = lookup(v)
+			}
+		}
+
+		s.expand(target, found, subs[field])
+	}
+}
+
+// find returns the entity of the endpoint with the provided key whose ID is
+// the provided value, projected to the provided fields, or nil if there is
+// no such entity.
+func (s *Server) find(key string, id interface{}, fields []string) query.Entity {
+	n, ok := id.(json.Number)
+	if !ok {
+		return nil
+	}
+	want, err := n.Int64()
+	if err != nil {
+		return nil
+	}
+
+	for _, e := range s.data[key] {
+		if int64(e.ID()) == want {
+			return query.Project(e, fields, nil)
+		}
+	}
+
+	return nil
+}
+
+// writeJSON writes the provided value to w as JSON with an OK status.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b)
+}
+
+// writeError writes the provided error to w in the format of an IGDB server
+// error.
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"status":%d,"message":%q}`, status, err.Error())
+}
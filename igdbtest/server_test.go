@@ -0,0 +1,272 @@
+package igdbtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/pkg/errors"
+)
+
+const testGameList = "../test_data/game_list.json"
+
+// newTestServer returns a Server loaded with the Game list fixture.
+func newTestServer(t *testing.T) *Server {
+	s := NewServer()
+	if err := s.LoadFile(string(igdb.EndpointGame), testGameList); err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// gameIDs returns the IDs of the provided Games in order.
+func gameIDs(g []*igdb.Game) []int {
+	var ids []int
+	for _, v := range g {
+		ids = append(ids, v.ID)
+	}
+
+	return ids
+}
+
+func TestServer_Index(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []igdb.Option
+		wantIDs []int
+		wantErr error
+	}{
+		{"Default limit", nil, []int{105842, 32478, 98774, 104945, 69530}, nil},
+		{"Limit and offset", []igdb.Option{igdb.SetLimit(2), igdb.SetOffset(1)}, []int{32478, 98774}, nil},
+		{"Offset past end", []igdb.Option{igdb.SetOffset(10)}, nil, igdb.ErrNoResults},
+		{"Sort descending", []igdb.Option{igdb.SetOrder("popularity", igdb.OrderDescending)}, []int{105842, 104945, 32478, 69530, 98774}, nil},
+		{"Sort missing values last", []igdb.Option{igdb.SetOrder("first_release_date", igdb.OrderAscending), igdb.SetLimit(1)}, []int{32478}, nil},
+		{"Equals", []igdb.Option{igdb.SetFilter("name", igdb.OpEquals, `"Woodpunk"`)}, []int{104945}, nil},
+		{"Not equals null", []igdb.Option{igdb.SetFilter("genres", igdb.OpNotEquals, "null")}, []int{32478, 98774, 104945}, nil},
+		{"Greater than", []igdb.Option{igdb.SetFilter("popularity", igdb.OpGreaterThan, "2.5")}, []int{105842, 104945}, nil},
+		{"Array contains scalar", []igdb.Option{igdb.SetFilter("genres", igdb.OpEquals, "13")}, []int{32478}, nil},
+		{"Contains at least", []igdb.Option{igdb.SetFilter("genres", igdb.OpContainsAtLeast, "9", "15")}, []int{32478, 98774}, nil},
+		{"Not contains at least", []igdb.Option{igdb.SetFilter("genres", igdb.OpNotContainsAtLeast, "9", "15")}, []int{105842, 104945, 69530}, nil},
+		{"Contains all", []igdb.Option{igdb.SetFilter("genres", igdb.OpContainsAll, "15", "32")}, []int{98774}, nil},
+		{"Contains exactly", []igdb.Option{igdb.SetFilter("genres", igdb.OpContainsExactly, "32")}, []int{104945}, nil},
+		{"Multiple filters", []igdb.Option{igdb.SetFilter("genres", igdb.OpEquals, "32"), igdb.SetFilter("popularity", igdb.OpLessThan, "2")}, []int{98774}, nil},
+		{"No matches", []igdb.Option{igdb.SetFilter("id", igdb.OpEquals, "1")}, nil, igdb.ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestServer(t)
+			defer s.Close()
+
+			g, err := s.NewClient().Games.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gameIDs(g), test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", gameIDs(g), test.wantIDs)
+			}
+		})
+	}
+}
+
+func TestServer_Fields(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Close()
+	c := s.NewClient()
+
+	g, err := c.Games.Get(104945)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.ID != 104945 || g.Name != "" {
+		t.Errorf("got: <%v>, want only the ID", g)
+	}
+
+	g, err = c.Games.Get(104945, igdb.SetFields("*"), igdb.SetExclude("summary"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Name != "Woodpunk" || g.Summary != "" {
+		t.Errorf("got: <%v>, want name without summary", g)
+	}
+}
+
+func TestServer_Search(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Close()
+
+	g, err := s.NewClient().Games.Search("the game")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(gameIDs(g), []int{32478}) {
+		t.Errorf("got: <%v>, want: <%v>", gameIDs(g), []int{32478})
+	}
+}
+
+func TestServer_Count(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Close()
+
+	ct, err := s.NewClient().Games.Count(igdb.SetFilter("genres", igdb.OpEquals, "32"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct != 3 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 3)
+	}
+}
+
+func TestServer_Meta(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	err := s.Load(string(igdb.EndpointGenre), strings.NewReader(`[{"id": 1, "name": "Shooter"}, {"id": 2, "slug": "rpg"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := s.NewClient().Genres.Fields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"id", "name", "slug"}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("got: <%v>, want: <%v>", f, want)
+	}
+}
+
+func TestServer_Add(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	err := s.Add(string(igdb.EndpointGenre), &igdb.Genre{BaseEntity: igdb.BaseEntity{ID: 1, Name: "Shooter"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Add(string(igdb.EndpointGenre), &igdb.Genre{BaseEntity: igdb.BaseEntity{ID: 1, Name: "Shoot 'em up"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := s.NewClient().Genres.Index(igdb.SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g) != 1 || g[0].Name != "Shoot 'em up" {
+		t.Errorf("got: <%v>, want a single replaced Genre", g)
+	}
+}
+
+func TestServer_LoadAll(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	err := s.LoadAll(map[string]string{
+		string(igdb.EndpointGenre): `[{"id": 1, "name": "Shooter"}, {"id": 2, "name": "Puzzle"}]`,
+		string(igdb.EndpointTheme): `[{"id": 1, "name": "Horror"}]`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := s.NewClient()
+	g, err := c.Genres.Index(igdb.SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g) != 2 {
+		t.Errorf("got: <%v>, want: <%v>", len(g), 2)
+	}
+
+	th, err := c.Themes.Get(1, igdb.SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Name != "Horror" {
+		t.Errorf("got: <%v>, want: <%v>", th.Name, "Horror")
+	}
+
+	err = s.LoadAll(map[string]string{string(igdb.EndpointGenre): `{"id": 3}`})
+	if err == nil {
+		t.Errorf("got: <%v>, want an error", err)
+	}
+}
+
+func TestServer_Reference(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	err := s.LoadAll(map[string]string{
+		string(igdb.EndpointGame):            `[{"id": 7, "name": "Celeste", "cover": 70, "involved_companies": [30, 31]}]`,
+		string(igdb.EndpointCover):           `[{"id": 70, "image_id": "co70", "width": 264}]`,
+		string(igdb.EndpointInvolvedCompany): `[{"id": 30, "company": 3, "developer": true}]`,
+		string(igdb.EndpointCompany):         `[{"id": 3, "name": "Matt Makes Games"}]`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Reference(string(igdb.EndpointGame), "cover", string(igdb.EndpointCover))
+	s.Reference(string(igdb.EndpointGame), "involved_companies", string(igdb.EndpointInvolvedCompany))
+	s.Reference(string(igdb.EndpointInvolvedCompany), "company", string(igdb.EndpointCompany))
+
+	tests := []struct {
+		name string
+		qry  string
+		want string
+	}{
+		{"Unexpanded", "fields name,cover;", `[{"cover":70,"id":7,"name":"Celeste"}]`},
+		{"Subfield", "fields cover.image_id;", `[{"cover":{"id":70,"image_id":"co70"},"id":7}]`},
+		{"Every subfield", "fields cover.*;", `[{"cover":{"id":70,"image_id":"co70","width":264},"id":7}]`},
+		{"Nested", "fields involved_companies.company.name;",
+			`[{"id":7,"involved_companies":[{"company":{"id":3,"name":"Matt Makes Games"},"id":30},31]}]`},
+		{"Undeclared", "fields name.*;", `[{"id":7,"name":"Celeste"}]`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := s.Client().Post(s.URL+"/games", "text/plain", strings.NewReader(test.qry))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.TrimSpace(string(b)); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestServer_BadQuery(t *testing.T) {
+	s := newTestServer(t)
+	defer s.Close()
+
+	tests := []struct {
+		name string
+		opts []igdb.Option
+	}{
+		{"Unknown operator", []igdb.Option{igdb.SetFilter("id", "%s ?? %s", "1")}},
+		{"Limit above maximum", []igdb.Option{igdb.SetLimit(501)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := s.NewClient().Games.Index(test.opts...)
+			if se, ok := errors.Cause(err).(igdb.ServerError); !ok || se.Status != http.StatusBadRequest {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), igdb.ErrBadRequest)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package igdb
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/Henry-Sarabia/blank"
 )
 
@@ -12,6 +14,8 @@ var (
 	ErrBlankID = errors.New("image id value empty")
 	// ErrPixelRatio occurs when an unsupported display pixel ratio is used as an argument in a function.
 	ErrPixelRatio = errors.New("invalid display pixel ratio")
+	// ErrImageFormat occurs when an unsupported image format is used as an argument in a function.
+	ErrImageFormat = errors.New("invalid image format")
 )
 
 //go:generate gomodifytags -file $GOFILE -struct Image -add-tags json -w
@@ -27,6 +31,9 @@ type Image struct {
 	Width        int    `json:"width"`
 }
 
+// imageBaseURL is the base URL of the images hosted by the IGDB.
+const imageBaseURL string = "https://images.igdb.com/igdb/image/upload/"
+
 // imageSize is the size of an image from the IGDB API. Note that this is not
 // the precise size of an image, but rather the maximum possible size of
 // the referenced image.
@@ -56,10 +63,130 @@ const (
 	Size1080p imageSize = "1080p"
 )
 
+// Available retina image sizes supported by the IGDB API. Each is double
+// the resolution of its 1x counterpart and is equivalent to requesting the
+// 1x size with a display pixel ratio of 2.
+const (
+	// SizeCoverSmall2x is sized at 180x256.
+	SizeCoverSmall2x imageSize = "cover_small_2x"
+	// SizeCoverBig2x is sized at 454x640.
+	SizeCoverBig2x imageSize = "cover_big_2x"
+	// SizeScreenshotMed2x is sized at 1138x640.
+	SizeScreenshotMed2x imageSize = "screenshot_med_2x"
+	// SizeScreenshotBig2x is sized at 1778x1000.
+	SizeScreenshotBig2x imageSize = "screenshot_big_2x"
+	// SizeScreenshotHuge2x is sized at 2560x1440.
+	SizeScreenshotHuge2x imageSize = "screenshot_huge_2x"
+	// SizeLogoMed2x is sized at 568x320.
+	SizeLogoMed2x imageSize = "logo_med_2x"
+	// SizeMicro2x is sized at 70x70.
+	SizeMicro2x imageSize = "micro_2x"
+	// SizeThumb2x is sized at 180x180.
+	SizeThumb2x imageSize = "thumb_2x"
+	// Size720p2x is sized at 2560x1440.
+	Size720p2x imageSize = "720p_2x"
+	// Size1080p2x is sized at 3840x2160.
+	Size1080p2x imageSize = "1080p_2x"
+)
+
+// retinaSuffix is the suffix of an image size with a display pixel ratio
+// of 2.
+const retinaSuffix = "_2x"
+
+// imageDimensions holds the maximum width and height of each 1x image size.
+var imageDimensions = map[imageSize][2]int{
+	SizeCoverSmall:     {90, 128},
+	SizeCoverBig:       {227, 320},
+	SizeScreenshotMed:  {569, 320},
+	SizeScreenshotBig:  {889, 500},
+	SizeScreenshotHuge: {1280, 720},
+	SizeLogoMed:        {284, 160},
+	SizeMicro:          {35, 35},
+	SizeThumb:          {90, 90},
+	Size720p:           {1280, 720},
+	Size1080p:          {1920, 1080},
+}
+
+// ImageSizes lists every image size supported by the IGDB API, each 1x size
+// followed by its retina counterpart.
+var ImageSizes = []imageSize{
+	SizeCoverSmall, SizeCoverSmall2x,
+	SizeCoverBig, SizeCoverBig2x,
+	SizeScreenshotMed, SizeScreenshotMed2x,
+	SizeScreenshotBig, SizeScreenshotBig2x,
+	SizeScreenshotHuge, SizeScreenshotHuge2x,
+	SizeLogoMed, SizeLogoMed2x,
+	SizeMicro, SizeMicro2x,
+	SizeThumb, SizeThumb2x,
+	Size720p, Size720p2x,
+	Size1080p, Size1080p2x,
+}
+
+// base returns the 1x image size and display pixel ratio of the image size.
+func (s imageSize) base() (imageSize, int) {
+	if strings.HasSuffix(string(s), retinaSuffix) {
+		return imageSize(strings.TrimSuffix(string(s), retinaSuffix)), 2
+	}
+
+	return s, 1
+}
+
+// Dimensions returns the maximum width and height of an image of this size.
+// Zeros are returned for an unknown size.
+func (s imageSize) Dimensions() (width, height int) {
+	b, ratio := s.base()
+	d, ok := imageDimensions[b]
+	if !ok {
+		return 0, 0
+	}
+
+	return d[0] * ratio, d[1] * ratio
+}
+
+// imageFormat is the file format an image is served in by the IGDB API.
+type imageFormat string
+
+// Available image formats supported by the IGDB API
+const (
+	// FormatJPEG is a lossy format without transparency.
+	FormatJPEG imageFormat = "jpg"
+	// FormatPNG is a lossless format supporting transparency.
+	FormatPNG imageFormat = "png"
+	// FormatGIF supports animation.
+	FormatGIF imageFormat = "gif"
+	// FormatWebP is a compact format supporting transparency and animation.
+	FormatWebP imageFormat = "webp"
+)
+
+// isValid returns true if the image format is supported by the IGDB API.
+func (f imageFormat) isValid() bool {
+	switch f {
+	case FormatJPEG, FormatPNG, FormatGIF, FormatWebP:
+		return true
+	}
+
+	return false
+}
+
 // SizedImageURL returns the URL of an image identified by the provided imageID,
 // image size, and display pixel ratio. The display pixel ratio only multiplies
-// the resolution of the image. The current available ratios are 1 and 2.
+// the resolution of the image. The current available ratios are 1 and 2. A
+// retina image size such as SizeLogoMed2x may only be used with a ratio of 1.
+// The image is served as a JPEG.
 func SizedImageURL(imageID string, size imageSize, ratio int) (string, error) {
+	return sizedImageURL(imageBaseURL, imageID, size, ratio, FormatJPEG)
+}
+
+// FormattedImageURL returns the URL of an image identified by the provided
+// imageID, image size, and display pixel ratio, served in the provided image
+// format.
+func FormattedImageURL(imageID string, size imageSize, ratio int, format imageFormat) (string, error) {
+	return sizedImageURL(imageBaseURL, imageID, size, ratio, format)
+}
+
+// sizedImageURL returns the URL of an image relative to the provided base
+// URL of the IGDB image host.
+func sizedImageURL(base, imageID string, size imageSize, ratio int, format imageFormat) (string, error) {
 	if blank.Is(imageID) {
 		return "", ErrBlankID
 	}
@@ -70,18 +197,46 @@ func SizedImageURL(imageID string, size imageSize, ratio int) (string, error) {
 	case 1:
 		dpr = ""
 	case 2:
-		dpr = "_2x"
+		if _, r := size.base(); r == 2 {
+			return "", ErrPixelRatio
+		}
+		dpr = retinaSuffix
 	default:
 		return "", ErrPixelRatio
 	}
 
-	url := fmt.Sprintf("https://images.igdb.com/igdb/image/upload/t_%s%s/%s.jpg", size, dpr, imageID)
+	if !format.isValid() {
+		return "", ErrImageFormat
+	}
+
+	url := fmt.Sprintf("%st_%s%s/%s.%s", base, size, dpr, imageID, format)
 	return url, nil
 }
 
+// Format returns the image format best suited to this image: a GIF if it is
+// animated, a PNG if it has an alpha channel, and a JPEG otherwise.
+func (i Image) Format() imageFormat {
+	switch {
+	case i.Animated:
+		return FormatGIF
+	case i.AlphaChannel:
+		return FormatPNG
+	default:
+		return FormatJPEG
+	}
+}
+
 // SizedURL returns the URL of this image at the provided image size
 // and display pixel ratio. The display pixel ratio only multiplies
 // the resolution of the image. The current available ratios are 1 and 2.
+// The image is served in the format returned by Format, so animations and
+// transparency are preserved.
 func (i Image) SizedURL(size imageSize, ratio int) (string, error) {
-	return SizedImageURL(i.ImageID, size, ratio)
+	return sizedImageURL(imageBaseURL, i.ImageID, size, ratio, i.Format())
+}
+
+// FormattedURL returns the URL of this image at the provided image size
+// and display pixel ratio, served in the provided image format.
+func (i Image) FormattedURL(size imageSize, ratio int, format imageFormat) (string, error) {
+	return sizedImageURL(imageBaseURL, i.ImageID, size, ratio, format)
 }
@@ -73,3 +73,103 @@ func TestImage_SizedURL(t *testing.T) {
 		})
 	}
 }
+
+func TestFormattedImageURL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		size    imageSize
+		ratio   int
+		format  imageFormat
+		wantURL string
+		wantErr error
+	}{
+		{"JPEG", SizeLogoMed, 1, FormatJPEG, "https://images.igdb.com/igdb/image/upload/t_logo_med/" + testImageID + ".jpg", nil},
+		{"PNG", SizeLogoMed, 2, FormatPNG, "https://images.igdb.com/igdb/image/upload/t_logo_med_2x/" + testImageID + ".png", nil},
+		{"GIF", SizeThumb, 1, FormatGIF, "https://images.igdb.com/igdb/image/upload/t_thumb/" + testImageID + ".gif", nil},
+		{"WebP", Size1080p, 1, FormatWebP, "https://images.igdb.com/igdb/image/upload/t_1080p/" + testImageID + ".webp", nil},
+		{"Retina size", SizeLogoMed2x, 1, FormatPNG, "https://images.igdb.com/igdb/image/upload/t_logo_med_2x/" + testImageID + ".png", nil},
+		{"Retina size and double ratio", SizeLogoMed2x, 2, FormatPNG, "", ErrPixelRatio},
+		{"Unsupported format", SizeThumb, 1, imageFormat("bmp"), "", ErrImageFormat},
+		{"Empty format", SizeThumb, 1, "", "", ErrImageFormat},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			url, err := FormattedImageURL(testImageID, test.size, test.ratio, test.format)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if url != test.wantURL {
+				t.Errorf("got: <%v>, want: <%v>", url, test.wantURL)
+			}
+		})
+	}
+}
+
+func TestImage_Format(t *testing.T) {
+	var tests = []struct {
+		name    string
+		image   Image
+		want    imageFormat
+		wantURL string
+	}{
+		{"Opaque", Image{ImageID: testImageID}, FormatJPEG, testImageURL},
+		{"Alpha channel", Image{ImageID: testImageID, AlphaChannel: true}, FormatPNG, "https://images.igdb.com/igdb/image/upload/t_screenshot_med/" + testImageID + ".png"},
+		{"Animated", Image{ImageID: testImageID, Animated: true}, FormatGIF, "https://images.igdb.com/igdb/image/upload/t_screenshot_med/" + testImageID + ".gif"},
+		{"Animated with alpha channel", Image{ImageID: testImageID, Animated: true, AlphaChannel: true}, FormatGIF, "https://images.igdb.com/igdb/image/upload/t_screenshot_med/" + testImageID + ".gif"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.image.Format(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+
+			url, err := test.image.SizedURL(SizeScreenshotMed, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if url != test.wantURL {
+				t.Errorf("got: <%v>, want: <%v>", url, test.wantURL)
+			}
+		})
+	}
+
+	url, err := Image{ImageID: testImageID, AlphaChannel: true}.FormattedURL(SizeScreenshotMed, 1, FormatWebP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://images.igdb.com/igdb/image/upload/t_screenshot_med/" + testImageID + ".webp"; url != want {
+		t.Errorf("got: <%v>, want: <%v>", url, want)
+	}
+}
+
+func TestImageSize_Dimensions(t *testing.T) {
+	var tests = []struct {
+		name  string
+		size  imageSize
+		wantW int
+		wantH int
+	}{
+		{"Cover", SizeCoverBig, 227, 320},
+		{"Retina logo", SizeLogoMed2x, 568, 320},
+		{"Retina 1080p", Size1080p2x, 3840, 2160},
+		{"Unknown", imageSize("poster"), 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, h := test.size.Dimensions()
+			if w != test.wantW || h != test.wantH {
+				t.Errorf("got: <%vx%v>, want: <%vx%v>", w, h, test.wantW, test.wantH)
+			}
+		})
+	}
+
+	for _, s := range ImageSizes {
+		if w, h := s.Dimensions(); w == 0 || h == 0 {
+			t.Errorf("got: <%vx%v>, want dimensions for size <%v>", w, h, s)
+		}
+	}
+}
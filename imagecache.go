@@ -0,0 +1,404 @@
+package igdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"image"
+	// Register the decoders of the formats served by the IGDB image host.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidImageID occurs when an image ID cannot be used to name a file in
+// an image cache, such as one containing a path separator.
+var ErrInvalidImageID = errors.New("invalid image id")
+
+// DefaultImageConcurrency is the number of images an ImageDownloader
+// downloads at once when its Concurrency is not positive.
+const DefaultImageConcurrency = 4
+
+// Directories within the cache directory of an ImageDownloader.
+const (
+	imageObjectDir  = "objects"
+	imageRefDir     = "refs"
+	imagePartialDir = "partial"
+)
+
+// ImageSource is an IGDB entity that embeds an Image, such as a Cover,
+// Artwork, Screenshot, CompanyLogo, PlatformLogo, or CharacterMugshot. An
+// Image is itself an ImageSource.
+type ImageSource interface {
+	image() Image
+}
+
+// image fulfills the ImageSource interface.
+func (i Image) image() Image {
+	return i
+}
+
+// CachedImage describes an image stored in the cache of an ImageDownloader.
+type CachedImage struct {
+	ImageID string      `json:"image_id"`
+	Size    imageSize   `json:"size"`
+	Ratio   int         `json:"ratio"`
+	Format  imageFormat `json:"format"`
+	URL     string      `json:"url"`
+	// Hash is the hex encoded SHA-256 hash of the image's content, which
+	// also names the file it is stored in.
+	Hash string `json:"hash"`
+	// Path is the location of the image's file on disk.
+	Path        string `json:"-"`
+	Bytes       int64  `json:"bytes"`
+	ContentType string `json:"content_type"`
+	// Width and Height are the dimensions of the downloaded image. They are
+	// zero if the image's format cannot be decoded.
+	Width        int  `json:"width"`
+	Height       int  `json:"height"`
+	AlphaChannel bool `json:"alpha_channel"`
+	Animated     bool `json:"animated"`
+}
+
+// ImageDownloader downloads IGDB images into a content-addressed cache
+// directory. Each image is stored once in a file named after the hash of
+// its content, no matter how many image IDs, sizes, or ratios refer to it.
+// Images that are already cached are not downloaded again, and a download
+// that was interrupted resumes from where it stopped when the server
+// supports range requests.
+//
+// An ImageDownloader is safe for concurrent use.
+type ImageDownloader struct {
+	// BaseURL is the URL images are downloaded from. It defaults to the IGDB
+	// image host and may be pointed at another server, such as in tests.
+	BaseURL string
+	// Concurrency is the largest number of images DownloadAll downloads at
+	// once.
+	Concurrency int
+
+	http  *http.Client
+	dir   string
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// NewImageDownloader returns an ImageDownloader caching images in the
+// provided directory, which is created if it does not exist. The provided
+// HTTP client makes the downloads. If no HTTP client is provided, a default
+// HTTP client is used instead.
+func NewImageDownloader(dir string, custom *http.Client) (*ImageDownloader, error) {
+	for _, sub := range []string{imageObjectDir, imageRefDir, imagePartialDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return nil, errors.Wrapf(err, "cannot create image cache directory '%s'", dir)
+		}
+	}
+
+	if custom == nil {
+		custom = http.DefaultClient
+	}
+
+	return &ImageDownloader{
+		BaseURL:     imageBaseURL,
+		Concurrency: DefaultImageConcurrency,
+		http:        custom,
+		dir:         dir,
+		locks:       make(map[string]*sync.Mutex),
+	}, nil
+}
+
+// Cached returns the cached image of the provided source at the provided
+// image size and display pixel ratio, if it has been downloaded.
+func (d *ImageDownloader) Cached(src ImageSource, size imageSize, ratio int) (*CachedImage, bool) {
+	img := src.image()
+
+	key, err := imageKey(img.ImageID, size, ratio, img.Format())
+	if err != nil {
+		return nil, false
+	}
+
+	return d.cached(key)
+}
+
+// Download returns the cached image of the provided source at the provided
+// image size and display pixel ratio, downloading it first if it is not
+// cached yet. The image is downloaded in the format returned by its Format
+// method.
+func (d *ImageDownloader) Download(src ImageSource, size imageSize, ratio int) (*CachedImage, error) {
+	img := src.image()
+
+	key, err := imageKey(img.ImageID, size, ratio, img.Format())
+	if err != nil {
+		return nil, err
+	}
+
+	lock := d.lock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if ci, ok := d.cached(key); ok {
+		return ci, nil
+	}
+
+	url, err := sizedImageURL(d.BaseURL, img.ImageID, size, ratio, img.Format())
+	if err != nil {
+		return nil, err
+	}
+
+	part := filepath.Join(d.dir, imagePartialDir, key+".part")
+	if err := os.MkdirAll(filepath.Dir(part), 0755); err != nil {
+		return nil, errors.Wrap(err, "cannot create partial image directory")
+	}
+
+	ctype, err := d.fetch(url, part)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot download image '%s'", img.ImageID)
+	}
+
+	ci := &CachedImage{
+		ImageID:      img.ImageID,
+		Size:         size,
+		Ratio:        ratio,
+		Format:       img.Format(),
+		URL:          url,
+		ContentType:  ctype,
+		AlphaChannel: img.AlphaChannel,
+		Animated:     img.Animated,
+	}
+	if err := d.store(ci, part); err != nil {
+		return nil, errors.Wrapf(err, "cannot cache image '%s'", img.ImageID)
+	}
+
+	if err := d.writeRef(key, ci); err != nil {
+		return nil, errors.Wrapf(err, "cannot cache image '%s'", img.ImageID)
+	}
+
+	return ci, nil
+}
+
+// DownloadAll downloads the provided sources at the provided image size and
+// display pixel ratio, up to Concurrency at a time. The cached images are
+// returned in the order of their sources. A failed download does not stop
+// the others; its cached image is nil and the first error encountered is
+// returned.
+func (d *ImageDownloader) DownloadAll(srcs []ImageSource, size imageSize, ratio int) ([]*CachedImage, error) {
+	n := d.Concurrency
+	if n <= 0 {
+		n = DefaultImageConcurrency
+	}
+
+	imgs := make([]*CachedImage, len(srcs))
+	errs := make([]error, len(srcs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, n)
+	for i, src := range srcs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, src ImageSource) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			imgs[i], errs[i] = d.Download(src, size, ratio)
+		}(i, src)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return imgs, err
+		}
+	}
+
+	return imgs, nil
+}
+
+// lock returns the mutex guarding the cache entry with the provided key.
+func (d *ImageDownloader) lock(key string) *sync.Mutex {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	l, ok := d.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		d.locks[key] = l
+	}
+
+	return l
+}
+
+// cached returns the cached image with the provided key if both its
+// reference and its file exist.
+func (d *ImageDownloader) cached(key string) (*CachedImage, bool) {
+	b, err := ioutil.ReadFile(filepath.Join(d.dir, imageRefDir, key+".json"))
+	if err != nil {
+		return nil, false
+	}
+
+	ci := &CachedImage{}
+	if err := json.Unmarshal(b, ci); err != nil {
+		return nil, false
+	}
+
+	ci.Path = d.objectPath(ci.Hash)
+	if _, err := os.Stat(ci.Path); err != nil {
+		return nil, false
+	}
+
+	return ci, true
+}
+
+// fetch downloads the image at the provided URL into the provided partial
+// file and returns its content type. If the partial file already holds the
+// start of the image, only the rest is requested.
+func (d *ImageDownloader) fetch(url, part string) (string, error) {
+	var offset int64
+	if fi, err := os.Stat(part); err == nil {
+		offset = fi.Size()
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot make request")
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := d.http.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "http client cannot send request")
+	}
+	defer resp.Body.Close()
+
+	flag := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flag |= os.O_APPEND
+	case http.StatusOK:
+		flag |= os.O_TRUNC
+	case http.StatusRequestedRangeNotSatisfiable:
+		if offset == 0 {
+			return "", errors.Errorf("unexpected status '%s'", resp.Status)
+		}
+		// The partial file is not a prefix of the image, so start over.
+		if err := os.Remove(part); err != nil {
+			return "", err
+		}
+		return d.fetch(url, part)
+	default:
+		return "", errors.Errorf("unexpected status '%s'", resp.Status)
+	}
+
+	f, err := os.OpenFile(part, flag, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return "", errors.Wrap(err, "cannot read response body")
+	}
+
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	return resp.Header.Get("Content-Type"), nil
+}
+
+// store moves the completed partial file into the cache under the hash of
+// its content and fills in the hash, path, size, and dimensions of the
+// provided cached image.
+func (d *ImageDownloader) store(ci *CachedImage, part string) error {
+	f, err := os.Open(part)
+	if err != nil {
+		return err
+	}
+
+	h := sha256.New()
+	ci.Bytes, err = io.Copy(h, f)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err == nil {
+		if cfg, _, cerr := image.DecodeConfig(f); cerr == nil {
+			ci.Width, ci.Height = cfg.Width, cfg.Height
+		}
+	}
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	ci.Hash = hex.EncodeToString(h.Sum(nil))
+	ci.Path = d.objectPath(ci.Hash)
+
+	if _, err := os.Stat(ci.Path); err == nil {
+		return os.Remove(part)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ci.Path), 0755); err != nil {
+		return err
+	}
+
+	return os.Rename(part, ci.Path)
+}
+
+// writeRef records the provided cached image under the provided key.
+func (d *ImageDownloader) writeRef(key string, ci *CachedImage) error {
+	b, err := json.Marshal(ci)
+	if err != nil {
+		return err
+	}
+
+	ref := filepath.Join(d.dir, imageRefDir, key+".json")
+	if err := os.MkdirAll(filepath.Dir(ref), 0755); err != nil {
+		return err
+	}
+
+	tmp := ref + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, ref)
+}
+
+// objectPath returns the path of the file holding the content with the
+// provided hash.
+func (d *ImageDownloader) objectPath(hash string) string {
+	if len(hash) < 2 {
+		return filepath.Join(d.dir, imageObjectDir, hash)
+	}
+
+	return filepath.Join(d.dir, imageObjectDir, hash[:2], hash)
+}
+
+// imageKey returns the relative path identifying the image with the
+// provided ID, size, ratio, and format within the cache.
+func imageKey(imageID string, size imageSize, ratio int, format imageFormat) (string, error) {
+	if _, err := sizedImageURL("", imageID, size, ratio, format); err != nil {
+		return "", err
+	}
+	for _, s := range []string{imageID, string(size)} {
+		if strings.ContainsAny(s, `/\`) || strings.Contains(s, "..") {
+			return "", ErrInvalidImageID
+		}
+	}
+
+	dir := string(size)
+	if ratio == 2 {
+		dir += retinaSuffix
+	}
+
+	return filepath.Join(dir, imageID+"."+string(format)), nil
+}
@@ -0,0 +1,242 @@
+package igdb
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// testPNG returns a PNG encoded image with the provided dimensions.
+func testPNG(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		img.Set(x, 0, color.RGBA{uint8(x), 0, 0, 255})
+	}
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return b.Bytes()
+}
+
+// testImageServer returns a server serving the provided images by path, a
+// downloader pointed at it, and the number of requests made.
+func testImageServer(t *testing.T, imgs map[string][]byte) (*httptest.Server, *ImageDownloader, *int32) {
+	var n int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&n, 1)
+		b, ok := imgs[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(b))
+	}))
+
+	dir, err := ioutil.TempDir("", "igdb-images")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := NewImageDownloader(dir, ts.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.BaseURL = ts.URL + "/"
+
+	return ts, d, &n
+}
+
+func TestImageDownloader_Download(t *testing.T) {
+	pic := testPNG(t, 90, 128)
+	ts, d, n := testImageServer(t, map[string][]byte{
+		"/t_cover_small/abc.png":    pic,
+		"/t_cover_small_2x/abc.png": pic,
+	})
+	defer ts.Close()
+	defer os.RemoveAll(d.dir)
+
+	cover := &Cover{Image: Image{ImageID: "abc", AlphaChannel: true}}
+
+	ci, err := d.Download(cover, SizeCoverSmall, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ci.Width != 90 || ci.Height != 128 {
+		t.Errorf("got: <%vx%v>, want: <%vx%v>", ci.Width, ci.Height, 90, 128)
+	}
+	if !ci.AlphaChannel {
+		t.Errorf("got: <%v>, want: <%v>", ci.AlphaChannel, true)
+	}
+	if ci.ContentType != "image/png" {
+		t.Errorf("got: <%v>, want: <%v>", ci.ContentType, "image/png")
+	}
+	if ci.Bytes != int64(len(pic)) {
+		t.Errorf("got: <%v>, want: <%v>", ci.Bytes, len(pic))
+	}
+
+	b, err := ioutil.ReadFile(ci.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, pic) {
+		t.Errorf("got: <%v bytes>, want: <%v bytes>", len(b), len(pic))
+	}
+	if filepath.Base(ci.Path) != ci.Hash {
+		t.Errorf("got: <%v>, want: <%v>", filepath.Base(ci.Path), ci.Hash)
+	}
+
+	again, err := d.Download(cover, SizeCoverSmall, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *again != *ci {
+		t.Errorf("got: <%v>, want: <%v>", again, ci)
+	}
+	if *n != 1 {
+		t.Errorf("got: <%v> requests, want: <%v>", *n, 1)
+	}
+
+	big, err := d.Download(cover.Image, SizeCoverSmall, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if big.Path != ci.Path {
+		t.Errorf("got: <%v>, want: <%v>", big.Path, ci.Path)
+	}
+
+	if _, ok := d.Cached(cover, SizeCoverBig, 1); ok {
+		t.Errorf("got: <%v>, want: <%v>", ok, false)
+	}
+	if _, ok := d.Cached(cover, SizeCoverSmall, 2); !ok {
+		t.Errorf("got: <%v>, want: <%v>", ok, true)
+	}
+}
+
+func TestImageDownloader_DownloadErrors(t *testing.T) {
+	ts, d, _ := testImageServer(t, nil)
+	defer ts.Close()
+	defer os.RemoveAll(d.dir)
+
+	tests := []struct {
+		name    string
+		img     Image
+		ratio   int
+		wantErr error
+	}{
+		{"Blank ID", Image{}, 1, ErrBlankID},
+		{"Invalid ratio", Image{ImageID: "abc"}, 3, ErrPixelRatio},
+		{"Path in ID", Image{ImageID: "../abc"}, 1, ErrInvalidImageID},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := d.Download(test.img, SizeThumb, test.ratio)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+
+	_, err := d.Download(Image{ImageID: "missing"}, SizeThumb, 1)
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("got: <%v>, want status error", err)
+	}
+}
+
+func TestImageDownloader_Resume(t *testing.T) {
+	pic := testPNG(t, 35, 35)
+	var ranges []string
+	ts, d, _ := testImageServer(t, map[string][]byte{"/t_micro/abc.jpg": pic})
+	defer ts.Close()
+	defer os.RemoveAll(d.dir)
+
+	base := ts.Config.Handler
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		base.ServeHTTP(w, r)
+	})
+
+	part := filepath.Join(d.dir, imagePartialDir, "micro", "abc.jpg.part")
+	if err := os.MkdirAll(filepath.Dir(part), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(part, pic[:10], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ci, err := d.Download(Image{ImageID: "abc"}, SizeMicro, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ranges) != 1 || ranges[0] != "bytes=10-" {
+		t.Errorf("got: <%v>, want: <%v>", ranges, []string{"bytes=10-"})
+	}
+
+	b, err := ioutil.ReadFile(ci.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, pic) {
+		t.Errorf("got: <%v bytes>, want: <%v bytes>", len(b), len(pic))
+	}
+	if _, err := os.Stat(part); !os.IsNotExist(err) {
+		t.Errorf("got: <%v>, want partial file removed", err)
+	}
+}
+
+func TestImageDownloader_DownloadAll(t *testing.T) {
+	imgs := map[string][]byte{
+		"/t_thumb/a.jpg": testPNG(t, 10, 10),
+		"/t_thumb/b.jpg": testPNG(t, 20, 10),
+		"/t_thumb/c.jpg": testPNG(t, 30, 10),
+	}
+	ts, d, _ := testImageServer(t, imgs)
+	defer ts.Close()
+	defer os.RemoveAll(d.dir)
+	d.Concurrency = 2
+
+	srcs := []ImageSource{
+		&Artwork{Image: Image{ImageID: "a"}},
+		&Screenshot{Image: Image{ImageID: "b"}},
+		Image{ImageID: "missing"},
+		&CompanyLogo{Image: Image{ImageID: "c"}},
+	}
+
+	got, err := d.DownloadAll(srcs, SizeThumb, 1)
+	if err == nil {
+		t.Errorf("got: <%v>, want: an error", err)
+	}
+	if len(got) != len(srcs) {
+		t.Fatalf("got: <%v>, want: <%v>", len(got), len(srcs))
+	}
+
+	wantWidths := []int{10, 20, 0, 30}
+	for i, w := range wantWidths {
+		if w == 0 {
+			if got[i] != nil {
+				t.Errorf("got: <%v>, want: <%v>", got[i], nil)
+			}
+			continue
+		}
+		if got[i] == nil || got[i].Width != w {
+			t.Errorf("got: <%v>, want width: <%v>", got[i], w)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package igdb
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrImageURL occurs when a URL does not address an image hosted by the
+// IGDB.
+var ErrImageURL = errors.New("invalid IGDB image url")
+
+// imageHost is the host of the images hosted by the IGDB.
+const imageHost = "images.igdb.com"
+
+// imageUploadPath is the path of the directory holding the images hosted by
+// the IGDB.
+const imageUploadPath = "/igdb/image/upload/"
+
+// ImageURL contains the parts of the URL of an image hosted by the IGDB.
+// The image size is always a 1x size, with any retina variant expressed by
+// the display pixel ratio, so the parts can be passed to SizedImageURL or
+// FormattedImageURL to address the image at another size.
+type ImageURL struct {
+	ImageID string
+	Size    imageSize
+	Ratio   int
+	Format  imageFormat
+}
+
+// ParseImageURL parses the provided URL of an image hosted by the IGDB,
+// such as the URL field of an Image. Protocol-relative URLs, as returned by
+// the IGDB API, are accepted along with http and https URLs.
+func ParseImageURL(rawURL string) (*ImageURL, error) {
+	u, err := url.Parse(NormalizeImageURL(rawURL))
+	if err != nil {
+		return nil, errors.Wrap(ErrImageURL, err.Error())
+	}
+
+	if u.Host != imageHost || !strings.HasPrefix(u.Path, imageUploadPath) {
+		return nil, errors.Wrapf(ErrImageURL, "cannot parse '%s'", rawURL)
+	}
+
+	dir, file := path.Split(strings.TrimPrefix(u.Path, imageUploadPath))
+	dir = strings.TrimSuffix(dir, "/")
+	if !strings.HasPrefix(dir, "t_") || strings.Contains(dir, "/") {
+		return nil, errors.Wrapf(ErrImageURL, "cannot parse size of '%s'", rawURL)
+	}
+
+	size, ratio := imageSize(strings.TrimPrefix(dir, "t_")).base()
+	if size == "" {
+		return nil, errors.Wrapf(ErrImageURL, "cannot parse size of '%s'", rawURL)
+	}
+
+	ext := path.Ext(file)
+	id := strings.TrimSuffix(file, ext)
+	if id == "" {
+		return nil, ErrBlankID
+	}
+
+	format := imageFormat(strings.TrimPrefix(ext, "."))
+	if !format.isValid() {
+		return nil, errors.Wrapf(ErrImageFormat, "cannot parse format of '%s'", rawURL)
+	}
+
+	return &ImageURL{ImageID: id, Size: size, Ratio: ratio, Format: format}, nil
+}
+
+// String returns the absolute https URL of the image.
+func (u ImageURL) String() string {
+	s, err := FormattedImageURL(u.ImageID, u.Size, u.Ratio, u.Format)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// NormalizeImageURL returns the provided URL of an image hosted by the IGDB
+// as an absolute https URL. Protocol-relative and http URLs, as well as URLs
+// without a scheme, are given the https scheme. Any other URL is returned
+// unchanged.
+func NormalizeImageURL(rawURL string) string {
+	s := strings.TrimSpace(rawURL)
+
+	switch {
+	case strings.HasPrefix(s, "//"):
+		return "https:" + s
+	case strings.HasPrefix(s, "http://"):
+		return "https://" + strings.TrimPrefix(s, "http://")
+	case strings.HasPrefix(s, imageHost+"/"):
+		return "https://" + s
+	}
+
+	return s
+}
+
+// NormalizedURL returns the URL of this image as an absolute https URL.
+func (i Image) NormalizedURL() string {
+	return NormalizeImageURL(i.URL)
+}
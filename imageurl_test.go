@@ -0,0 +1,95 @@
+package igdb
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseImageURL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		url     string
+		want    ImageURL
+		wantErr error
+	}{
+		{"Protocol-relative thumb", "//images.igdb.com/igdb/image/upload/t_thumb/abc123.jpg", ImageURL{"abc123", SizeThumb, 1, FormatJPEG}, nil},
+		{"Https", testImageURL, ImageURL{testImageID, SizeScreenshotMed, 1, FormatJPEG}, nil},
+		{"Http retina", "http://images.igdb.com/igdb/image/upload/t_logo_med_2x/abc123.png", ImageURL{"abc123", SizeLogoMed, 2, FormatPNG}, nil},
+		{"WebP", "https://images.igdb.com/igdb/image/upload/t_1080p/abc123.webp", ImageURL{"abc123", Size1080p, 1, FormatWebP}, nil},
+		{"Query string", "https://images.igdb.com/igdb/image/upload/t_720p/abc123.gif?v=2", ImageURL{"abc123", Size720p, 1, FormatGIF}, nil},
+		{"Other host", "https://example.com/igdb/image/upload/t_thumb/abc123.jpg", ImageURL{}, ErrImageURL},
+		{"Other path", "https://images.igdb.com/abc123.jpg", ImageURL{}, ErrImageURL},
+		{"Missing size", "https://images.igdb.com/igdb/image/upload/abc123.jpg", ImageURL{}, ErrImageURL},
+		{"Nested size", "https://images.igdb.com/igdb/image/upload/t_thumb/t_micro/abc123.jpg", ImageURL{}, ErrImageURL},
+		{"Blank ID", "https://images.igdb.com/igdb/image/upload/t_thumb/.jpg", ImageURL{}, ErrBlankID},
+		{"Unsupported format", "https://images.igdb.com/igdb/image/upload/t_thumb/abc123.bmp", ImageURL{}, ErrImageFormat},
+		{"Empty", "", ImageURL{}, ErrImageURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, err := ParseImageURL(test.url)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if *u != test.want {
+				t.Errorf("got: <%v>, want: <%v>", *u, test.want)
+			}
+		})
+	}
+}
+
+func TestImageURL_String(t *testing.T) {
+	u, err := ParseImageURL("//images.igdb.com/igdb/image/upload/t_screenshot_med_2x/" + testImageID + ".jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := u.String(); got != testImageURL2x {
+		t.Errorf("got: <%v>, want: <%v>", got, testImageURL2x)
+	}
+
+	resized, err := SizedImageURL(u.ImageID, SizeScreenshotMed, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resized != testImageURL {
+		t.Errorf("got: <%v>, want: <%v>", resized, testImageURL)
+	}
+
+	if got := (ImageURL{}).String(); got != "" {
+		t.Errorf("got: <%v>, want: <%v>", got, "")
+	}
+}
+
+func TestNormalizeImageURL(t *testing.T) {
+	var tests = []struct {
+		name string
+		url  string
+		want string
+	}{
+		{"Protocol-relative", "//images.igdb.com/igdb/image/upload/t_thumb/abc.jpg", "https://images.igdb.com/igdb/image/upload/t_thumb/abc.jpg"},
+		{"Http", "http://images.igdb.com/igdb/image/upload/t_thumb/abc.jpg", "https://images.igdb.com/igdb/image/upload/t_thumb/abc.jpg"},
+		{"No scheme", "images.igdb.com/igdb/image/upload/t_thumb/abc.jpg", "https://images.igdb.com/igdb/image/upload/t_thumb/abc.jpg"},
+		{"Https", testImageURL, testImageURL},
+		{"Surrounding space", " " + testImageURL + "\n", testImageURL},
+		{"Empty", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NormalizeImageURL(test.url); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+
+			if got := (Image{URL: test.url}).NormalizedURL(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
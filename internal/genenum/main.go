@@ -0,0 +1,271 @@
+// Command genenum generates the snake_case names, value lists, parse
+// functions, and text and JSON marshaling methods of the igdb enumerated
+// types, a Name type encoding each enumerated type to JSON as its names, and
+// a registry of every enumerated type. Every type listed in a stringer
+// go:generate directive is treated as an enumerated type. It is run by go
+// generate from the root of the repository.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the igdb package")
+	out := flag.String("out", "enumnames.go", "file to write the generated code to")
+	flag.Parse()
+
+	src, err := generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(*dir, *out), src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// enum is an enumerated type and its named constants in declaration order.
+type enum struct {
+	name   string
+	consts []string
+}
+
+// generate returns the formatted source generated for every enumerated type
+// declared in the package in the provided directory.
+func generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["igdb"]
+	if !ok {
+		return nil, fmt.Errorf("genenum: no igdb package in %s", dir)
+	}
+
+	var files []string
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	enums := make(map[string]*enum)
+	var order []string
+	for _, name := range files {
+		for _, typ := range stringerTypes(pkg.Files[name]) {
+			if _, ok := enums[typ]; !ok {
+				enums[typ] = &enum{name: typ}
+				order = append(order, typ)
+			}
+		}
+	}
+	for _, name := range files {
+		collectConsts(pkg.Files[name], enums)
+	}
+	sort.Strings(order)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by genenum. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package igdb\n\n")
+	for _, typ := range order {
+		writeEnum(&b, enums[typ])
+	}
+	writeRegistry(&b, order)
+
+	return format.Source(b.Bytes())
+}
+
+// stringerTypes returns the types listed in the stringer go:generate
+// directives of the provided file.
+func stringerTypes(f *ast.File) []string {
+	var types []string
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if !strings.HasPrefix(c.Text, "//go:generate stringer") {
+				continue
+			}
+			for _, arg := range strings.Fields(c.Text) {
+				if strings.HasPrefix(arg, "-type=") {
+					types = append(types, strings.Split(strings.TrimPrefix(arg, "-type="), ",")...)
+				}
+			}
+		}
+	}
+
+	return types
+}
+
+// collectConsts adds the constants of each enumerated type declared in the
+// provided file to its enum. A constant without a type or value repeats the
+// type of the constant before it, as in an iota sequence.
+func collectConsts(f *ast.File, enums map[string]*enum) {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		var typ string
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			switch {
+			case vs.Type != nil:
+				typ = ""
+				if id, ok := vs.Type.(*ast.Ident); ok {
+					typ = id.Name
+				}
+			case len(vs.Values) > 0:
+				typ = ""
+			}
+
+			e, ok := enums[typ]
+			if !ok {
+				continue
+			}
+			for _, n := range vs.Names {
+				if n.Name != "_" {
+					e.consts = append(e.consts, n.Name)
+				}
+			}
+		}
+	}
+}
+
+// writeEnum writes the names table and methods of a single enumerated type.
+func writeEnum(b *bytes.Buffer, e *enum) {
+	names := snakeNames(e.consts)
+	table := lowerFirst(e.name) + "Names"
+
+	fmt.Fprintf(b, "// %s holds the snake_case name of each %s.\n", table, e.name)
+	fmt.Fprintf(b, "var %s = []enumName{\n", table)
+	for i, c := range e.consts {
+		fmt.Fprintf(b, "{int(%s), %q},\n", c, names[i])
+	}
+	fmt.Fprintf(b, "}\n\n")
+
+	fmt.Fprintf(b, "// Name returns the snake_case name of the %s, or its number if it has no name.\n", e.name)
+	fmt.Fprintf(b, "func (i %s) Name() string {\nreturn nameOf(%s, int(i))\n}\n\n", e.name, table)
+
+	fmt.Fprintf(b, "// IsKnown returns true if the %s is one of its named values.\n", e.name)
+	fmt.Fprintf(b, "func (i %s) IsKnown() bool {\nreturn isKnown(%s, int(i))\n}\n\n", e.name, table)
+
+	fmt.Fprintf(b, "// %sValues returns every named %s in declaration order.\n", e.name, e.name)
+	fmt.Fprintf(b, "func %sValues() []%s {\n", e.name, e.name)
+	fmt.Fprintf(b, "v := make([]%s, len(%s))\nfor i, n := range %s {\nv[i] = %s(n.value)\n}\nreturn v\n}\n\n", e.name, table, table, e.name)
+
+	fmt.Fprintf(b, "// Parse%s returns the %s with the provided snake_case name or number.\n", e.name, e.name)
+	fmt.Fprintf(b, "func Parse%s(s string) (%s, error) {\n", e.name, e.name)
+	fmt.Fprintf(b, "v, err := parseName(%s, %q, s)\nreturn %s(v), err\n}\n\n", table, e.name, e.name)
+
+	fmt.Fprintf(b, "// MarshalText fulfills the encoding.TextMarshaler interface.\n")
+	fmt.Fprintf(b, "func (i %s) MarshalText() ([]byte, error) {\nreturn []byte(i.Name()), nil\n}\n\n", e.name)
+
+	fmt.Fprintf(b, "// UnmarshalText fulfills the encoding.TextUnmarshaler interface.\n")
+	fmt.Fprintf(b, "func (i *%s) UnmarshalText(b []byte) error {\n", e.name)
+	fmt.Fprintf(b, "v, err := Parse%s(string(b))\nif err != nil {\nreturn err\n}\n*i = v\nreturn nil\n}\n\n", e.name)
+
+	fmt.Fprintf(b, "// MarshalJSON fulfills the json.Marshaler interface.\n")
+	fmt.Fprintf(b, "func (i %s) MarshalJSON() ([]byte, error) {\nreturn marshalEnumJSON(int(i))\n}\n\n", e.name)
+
+	fmt.Fprintf(b, "// UnmarshalJSON fulfills the json.Unmarshaler interface.\n")
+	fmt.Fprintf(b, "func (i *%s) UnmarshalJSON(b []byte) error {\n", e.name)
+	fmt.Fprintf(b, "v, err := unmarshalEnumJSON(%s, %q, b)\nif err != nil {\nreturn err\n}\n*i = %s(v)\nreturn nil\n}\n\n", table, e.name, e.name)
+
+	wrap := e.name + "Name"
+	fmt.Fprintf(b, "// %s is a %s that is encoded to JSON as its snake_case name rather than its number.\n", wrap, e.name)
+	fmt.Fprintf(b, "type %s %s\n\n", wrap, e.name)
+
+	fmt.Fprintf(b, "// MarshalJSON fulfills the json.Marshaler interface.\n")
+	fmt.Fprintf(b, "func (i %s) MarshalJSON() ([]byte, error) {\nreturn marshalEnumNameJSON(%s, int(i))\n}\n\n", wrap, table)
+
+	fmt.Fprintf(b, "// UnmarshalJSON fulfills the json.Unmarshaler interface.\n")
+	fmt.Fprintf(b, "func (i *%s) UnmarshalJSON(b []byte) error {\nreturn (*%s)(i).UnmarshalJSON(b)\n}\n\n", wrap, e.name)
+}
+
+// writeRegistry writes the registry of every enumerated type, keyed by
+// type name.
+func writeRegistry(b *bytes.Buffer, order []string) {
+	fmt.Fprintf(b, "// enumRegistry holds the names table of every enumerated type.\n")
+	fmt.Fprintf(b, "var enumRegistry = map[string][]enumName{\n")
+	for _, typ := range order {
+		fmt.Fprintf(b, "%q: %sNames,\n", typ, lowerFirst(typ))
+	}
+	fmt.Fprintf(b, "}\n")
+}
+
+// snakeNames returns the snake_case names of the provided constants with the
+// words shared by every constant (e.g. Region in RegionEurope and
+// RegionJapan) removed from the front.
+func snakeNames(consts []string) []string {
+	words := make([][]string, len(consts))
+	for i, c := range consts {
+		words[i] = splitWords(c)
+	}
+
+	prefix := 0
+	if len(words) > 1 {
+	loop:
+		for ; ; prefix++ {
+			for _, w := range words {
+				if len(w) <= prefix+1 || w[prefix] != words[0][prefix] {
+					break loop
+				}
+			}
+		}
+	}
+
+	names := make([]string, len(consts))
+	for i, w := range words {
+		names[i] = strings.ToLower(strings.Join(w[prefix:], "_"))
+	}
+
+	return names
+}
+
+// splitWords splits a Go identifier into its words. A run of capital letters
+// is a single word (e.g. DLC in DLCAddon) and digits belong to the word
+// before them.
+func splitWords(s string) []string {
+	r := []rune(s)
+
+	var words []string
+	start := 0
+	for i := 1; i < len(r); i++ {
+		switch {
+		case unicode.IsUpper(r[i]) && unicode.IsLower(r[i-1]):
+		case unicode.IsUpper(r[i]) && unicode.IsDigit(r[i-1]) && i+1 < len(r) && unicode.IsLower(r[i+1]):
+		case unicode.IsUpper(r[i]) && unicode.IsUpper(r[i-1]) && i+1 < len(r) && unicode.IsLower(r[i+1]):
+		default:
+			continue
+		}
+		words = append(words, string(r[start:i]))
+		start = i
+	}
+
+	return append(words, string(r[start:]))
+}
+
+// lowerFirst returns s with its first letter in lower case.
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+
+	return string(r)
+}
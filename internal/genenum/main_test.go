@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	got, err := generate("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ioutil.ReadFile("../../enumnames.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("enumnames.go is out of date, run go generate")
+	}
+}
+
+func TestSnakeNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		consts []string
+		want   []string
+	}{
+		{"No shared prefix", []string{"MainGame", "DLCAddon", "StandaloneExpansion"}, []string{"main_game", "dlc_addon", "standalone_expansion"}},
+		{"Shared prefix", []string{"RegionEurope", "RegionNorthAmerica"}, []string{"europe", "north_america"}},
+		{"Shared multiword prefix", []string{"AgeRatingThree", "AgeRatingE10", "AgeRatingAO"}, []string{"three", "e10", "ao"}},
+		{"Acronyms", []string{"DateYYYYMMMMDD", "DateYYYYQ1", "DateTBD"}, []string{"yyyymmmmdd", "yyyyq1", "tbd"}},
+		{"Prefix is a whole name", []string{"Platform", "PlatformConsole"}, []string{"platform", "platform_console"}},
+		{"Single constant", []string{"ReviewText"}, []string{"review_text"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := snakeNames(test.consts)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
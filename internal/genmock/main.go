@@ -0,0 +1,167 @@
+// Command genmock generates mock implementations of the igdb Reader
+// interfaces. It is run by go generate from the root of the repository.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// pkgName is the name used to qualify identifiers from the igdb package.
+const pkgName = "igdb"
+
+func main() {
+	in := flag.String("in", "reader.go", "file declaring the interfaces to mock")
+	out := flag.String("out", "igdbmock/mock.go", "file to write the mocks to")
+	flag.Parse()
+
+	src, err := generate(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the mocks for every interface
+// declared in the provided file whose name ends in Reader.
+func generate(filename string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by genmock from %s. DO NOT EDIT.\n\n", filepath.Base(filename))
+	fmt.Fprintf(&b, "package igdbmock\n\nimport \"github.com/gotomgo/igdb\"\n\n")
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "Reader") {
+				continue
+			}
+			writeMock(&b, ts.Name.Name, it)
+		}
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// writeMock writes the mock of a single interface.
+func writeMock(b *bytes.Buffer, name string, it *ast.InterfaceType) {
+	fmt.Fprintf(b, "// %s is a mock implementation of igdb.%s. Each method calls\n", name, name)
+	fmt.Fprintf(b, "// the function field of the same name and panics if it is nil.\n")
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, m := range it.Methods.List {
+		ft := m.Type.(*ast.FuncType)
+		for _, n := range m.Names {
+			fmt.Fprintf(b, "%sFunc func%s\n", n.Name, signature(ft))
+		}
+	}
+	fmt.Fprintf(b, "}\n\n")
+
+	for _, m := range it.Methods.List {
+		ft := m.Type.(*ast.FuncType)
+		for _, n := range m.Names {
+			fmt.Fprintf(b, "// %s calls %sFunc.\n", n.Name, n.Name)
+			fmt.Fprintf(b, "func (m *%s) %s%s {\n", name, n.Name, signature(ft))
+			fmt.Fprintf(b, "if m.%sFunc == nil {\n", n.Name)
+			fmt.Fprintf(b, "panic(\"igdbmock: %s.%s called with nil %sFunc\")\n}\n", name, n.Name, n.Name)
+			fmt.Fprintf(b, "return m.%sFunc(%s)\n}\n\n", n.Name, arguments(ft))
+		}
+	}
+
+	fmt.Fprintf(b, "var _ igdb.%s = (*%s)(nil)\n\n", name, name)
+}
+
+// signature returns the parameters and results of the provided function type
+// with identifiers from the igdb package qualified.
+func signature(ft *ast.FuncType) string {
+	var params []string
+	for _, p := range ft.Params.List {
+		typ := typeString(p.Type)
+		for _, n := range p.Names {
+			params = append(params, n.Name+" "+typ)
+		}
+	}
+
+	var results []string
+	if ft.Results != nil {
+		for _, r := range ft.Results.List {
+			results = append(results, typeString(r.Type))
+		}
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+
+	return sig
+}
+
+// arguments returns the argument list used to forward a call with the
+// parameters of the provided function type.
+func arguments(ft *ast.FuncType) string {
+	var args []string
+	for _, p := range ft.Params.List {
+		_, variadic := p.Type.(*ast.Ellipsis)
+		for _, n := range p.Names {
+			if variadic {
+				args = append(args, n.Name+"...")
+				continue
+			}
+			args = append(args, n.Name)
+		}
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// typeString returns the source of the provided type expression with
+// exported identifiers qualified by the igdb package name.
+func typeString(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return pkgName + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+
+	panic(fmt.Sprintf("genmock: unsupported type %T", e))
+}
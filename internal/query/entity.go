@@ -0,0 +1,215 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultLimit is the number of results returned by Run when the query
+// provides no limit, mirroring the IGDB API.
+const DefaultLimit = 10
+
+// Entity is a single IGDB object decoded from JSON. Numbers are held as
+// json.Number values.
+type Entity map[string]interface{}
+
+// Decode decodes a JSON array of entities from the provided reader.
+func Decode(r io.Reader) ([]Entity, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var ents []Entity
+	if err := dec.Decode(&ents); err != nil {
+		return nil, err
+	}
+
+	return ents, nil
+}
+
+// DecodeOne decodes a single JSON entity.
+func DecodeOne(b []byte) (Entity, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var e Entity
+	if err := dec.Decode(&e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Lookup returns the value of the provided field. Subfields of embedded
+// objects are accessed with a dot operator (e.g. cover.url).
+func (e Entity) Lookup(field string) (interface{}, bool) {
+	var cur interface{} = map[string]interface{}(e)
+	for _, part := range strings.Split(field, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+// ID returns the ID of the entity, or -1 if it has none.
+func (e Entity) ID() int {
+	if f, ok := Number(e["id"]).(float64); ok {
+		return int(f)
+	}
+
+	return -1
+}
+
+// Match reports whether the provided entity satisfies the where and
+// search clauses of the query.
+func (q *Query) Match(e Entity) bool {
+	if q.Where != nil && !q.Where.Eval(e) {
+		return false
+	}
+	if q.HasSearch && !matchSearch(e, q.Search) {
+		return false
+	}
+
+	return true
+}
+
+// Filter returns the provided entities that satisfy the query, in order.
+func (q *Query) Filter(ents []Entity) []Entity {
+	var res []Entity
+	for _, e := range ents {
+		if q.Match(e) {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+// Run filters, sorts, paginates, and projects the provided entities
+// according to the query. An error is returned if the query's limit
+// exceeds maxLimit.
+func (q *Query) Run(ents []Entity, maxLimit int) ([]Entity, error) {
+	lim := q.Limit
+	if lim < 0 {
+		lim = DefaultLimit
+	}
+	if lim > maxLimit {
+		return nil, errors.Errorf("limit %d exceeds maximum of %d", lim, maxLimit)
+	}
+
+	res := q.Filter(ents)
+
+	if q.SortField != "" {
+		Sort(res, q.SortField, q.SortDesc)
+	}
+
+	if q.Offset >= len(res) {
+		return []Entity{}, nil
+	}
+	res = res[q.Offset:]
+	if len(res) > lim {
+		res = res[:lim]
+	}
+
+	out := make([]Entity, len(res))
+	for i, e := range res {
+		out[i] = Project(e, q.Fields, q.Exclude)
+	}
+
+	return out, nil
+}
+
+// FieldNames returns the sorted set of field names present on the
+// provided entities.
+func FieldNames(ents []Entity) []string {
+	set := make(map[string]bool)
+	for _, e := range ents {
+		for f := range e {
+			set[f] = true
+		}
+	}
+
+	f := make([]string, 0, len(set))
+	for k := range set {
+		f = append(f, k)
+	}
+	sort.Strings(f)
+
+	return f
+}
+
+// matchSearch reports whether every word of the provided search term appears
+// in the name of the provided entity, ignoring case.
+func matchSearch(e Entity, term string) bool {
+	name, _ := e["name"].(string)
+	name = strings.ToLower(name)
+	for _, w := range strings.Fields(strings.ToLower(term)) {
+		if !strings.Contains(name, w) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sort stably sorts the provided entities by the provided field. Entities
+// missing the field are placed last in either direction.
+func Sort(ents []Entity, field string, desc bool) {
+	sort.SliceStable(ents, func(i, j int) bool {
+		a, aok := ents[i].Lookup(field)
+		b, bok := ents[j].Lookup(field)
+		aok, bok = aok && a != nil, bok && b != nil
+		if !aok || !bok {
+			return aok && !bok
+		}
+
+		cmp, ok := Compare(a, b)
+		if !ok {
+			return false
+		}
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
+
+// Project returns a copy of the provided entity containing only the provided
+// fields, less any excluded fields. As with the IGDB API, only the ID is
+// returned when no fields are requested.
+func Project(e Entity, fields, exclude []string) Entity {
+	out := make(Entity)
+	if id, ok := e["id"]; ok {
+		out["id"] = id
+	}
+
+	for _, f := range fields {
+		if f == "*" {
+			for k, v := range e {
+				out[k] = v
+			}
+			continue
+		}
+
+		f = strings.SplitN(f, ".", 2)[0]
+		if v, ok := e[f]; ok {
+			out[f] = v
+		}
+	}
+
+	for _, f := range exclude {
+		delete(out, f)
+	}
+
+	return out
+}
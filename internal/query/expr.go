@@ -0,0 +1,398 @@
+package query
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Expr is a boolean expression from the where clause of a query.
+type Expr interface {
+	Eval(ent Entity) bool
+}
+
+// And is satisfied when both of its operands are satisfied.
+type And struct{ L, R Expr }
+
+// Eval fulfills the Expr interface.
+func (e And) Eval(ent Entity) bool { return e.L.Eval(ent) && e.R.Eval(ent) }
+
+// Or is satisfied when either of its operands is satisfied.
+type Or struct{ L, R Expr }
+
+// Eval fulfills the Expr interface.
+func (e Or) Eval(ent Entity) bool { return e.L.Eval(ent) || e.R.Eval(ent) }
+
+// Not is satisfied when its operand is not satisfied.
+type Not struct{ E Expr }
+
+// Eval fulfills the Expr interface.
+func (e Not) Eval(ent Entity) bool { return !e.E.Eval(ent) }
+
+// Delimiters used by a condition's value list. A scalar condition has none.
+const (
+	ListNone    = ""
+	ListAny     = "("
+	ListAll     = "["
+	ListExactly = "{"
+)
+
+// Cond compares a single entity field against one or more values. Values
+// are float64, string, bool, or nil for null.
+type Cond struct {
+	Field string
+	Op    string
+	List  string
+	Vals  []interface{}
+	// Prefix and Suffix mark string wildcards, e.g. name ~ *"zelda"*.
+	Prefix bool
+	Suffix bool
+}
+
+// parser is a recursive descent parser for where clauses.
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.toks) }
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// accept consumes the next token if it is the provided punctuation.
+func (p *parser) accept(punct string) bool {
+	if t := p.peek(); !p.done() && t.kind == tokPunct && t.val == punct {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("|") {
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = Or{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&") {
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = And{l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.accept("!") {
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{e}, nil
+	}
+
+	if p.accept("(") {
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, errors.Wrap(ErrSyntax, "missing closing parenthesis")
+		}
+		return e, nil
+	}
+
+	return p.parseCond()
+}
+
+func (p *parser) parseCond() (Expr, error) {
+	f := p.next()
+	if f.kind != tokIdent {
+		return nil, errors.Wrapf(ErrSyntax, "expected field, got %q", f.val)
+	}
+
+	op := p.next()
+	switch op.val {
+	case "=", "!=", ">", ">=", "<", "<=", "~":
+	default:
+		return nil, errors.Wrapf(ErrSyntax, "unknown operator %q", op.val)
+	}
+
+	c := &Cond{Field: f.val, Op: op.val}
+
+	for _, delim := range [][2]string{{ListAny, ")"}, {ListAll, "]"}, {ListExactly, "}"}} {
+		open, close := delim[0], delim[1]
+		if !p.accept(open) {
+			continue
+		}
+		c.List = open
+		for !p.accept(close) {
+			if p.done() {
+				return nil, errors.Wrapf(ErrSyntax, "missing %q", close)
+			}
+			if p.accept(",") {
+				continue
+			}
+			v, err := p.parseValue(c)
+			if err != nil {
+				return nil, err
+			}
+			c.Vals = append(c.Vals, v)
+		}
+		return c, nil
+	}
+
+	v, err := p.parseValue(c)
+	if err != nil {
+		return nil, err
+	}
+	c.Vals = []interface{}{v}
+
+	return c, nil
+}
+
+// parseValue parses a single literal value, recording any string wildcards on c.
+func (p *parser) parseValue(c *Cond) (interface{}, error) {
+	if p.accept("*") {
+		c.Prefix = true
+	}
+
+	t := p.next()
+	var v interface{}
+	switch t.kind {
+	case tokNumber:
+		n, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, errors.Wrap(ErrSyntax, err.Error())
+		}
+		v = n
+	case tokString:
+		v = t.val
+	case tokIdent:
+		switch strings.ToLower(t.val) {
+		case "null":
+			v = nil
+		case "true":
+			v = true
+		case "false":
+			v = false
+		default:
+			return nil, errors.Wrapf(ErrSyntax, "unexpected value %q", t.val)
+		}
+	default:
+		return nil, errors.Wrapf(ErrSyntax, "unexpected value %q", t.val)
+	}
+
+	if p.accept("*") {
+		c.Suffix = true
+	}
+
+	return v, nil
+}
+
+// Eval fulfills the Expr interface.
+func (c *Cond) Eval(ent Entity) bool {
+	fv, ok := ent.Lookup(c.Field)
+	if !ok {
+		fv = nil
+	}
+
+	arr, isArr := fv.([]interface{})
+
+	switch c.List {
+	case ListAny:
+		hit := false
+		for _, v := range c.Vals {
+			if c.contains(fv, arr, isArr, v) {
+				hit = true
+				break
+			}
+		}
+		return hit == (c.Op == "=")
+	case ListAll:
+		all := true
+		for _, v := range c.Vals {
+			if !c.contains(fv, arr, isArr, v) {
+				all = false
+				break
+			}
+		}
+		return all == (c.Op == "=")
+	case ListExactly:
+		exact := isArr && len(arr) == len(c.Vals)
+		for _, v := range c.Vals {
+			exact = exact && c.contains(fv, arr, isArr, v)
+		}
+		return exact == (c.Op == "=")
+	}
+
+	v := c.Vals[0]
+	if isArr {
+		switch c.Op {
+		case "=", "~":
+			return c.contains(fv, arr, isArr, v)
+		case "!=":
+			return !c.contains(fv, arr, isArr, v)
+		}
+		for _, el := range arr {
+			if c.compare(el, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return c.compare(fv, v)
+}
+
+// contains reports whether the field value fv, or any element of arr when fv
+// is an array, equals v.
+func (c *Cond) contains(fv interface{}, arr []interface{}, isArr bool, v interface{}) bool {
+	if !isArr {
+		return c.equal(fv, v)
+	}
+
+	for _, el := range arr {
+		if c.equal(el, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compare applies the condition's operator to a single field value.
+func (c *Cond) compare(fv, v interface{}) bool {
+	switch c.Op {
+	case "=", "~":
+		return c.equal(fv, v)
+	case "!=":
+		return !c.equal(fv, v)
+	}
+
+	if fv == nil || v == nil {
+		return false
+	}
+
+	cmp, ok := Compare(fv, v)
+	if !ok {
+		return false
+	}
+
+	switch c.Op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	return false
+}
+
+// equal reports whether the field value fv matches v, honoring the
+// case-insensitive operator and string wildcards.
+func (c *Cond) equal(fv, v interface{}) bool {
+	if v == nil {
+		return fv == nil
+	}
+
+	s, isStr := v.(string)
+	fs, fIsStr := fv.(string)
+	if isStr && fIsStr {
+		if c.Op == "~" {
+			s, fs = strings.ToLower(s), strings.ToLower(fs)
+		}
+		switch {
+		case c.Prefix && c.Suffix:
+			return strings.Contains(fs, s)
+		case c.Prefix:
+			return strings.HasSuffix(fs, s)
+		case c.Suffix:
+			return strings.HasPrefix(fs, s)
+		}
+		return fs == s
+	}
+
+	cmp, ok := Compare(fv, v)
+	return ok && cmp == 0
+}
+
+// Compare compares two scalar values of the same kind. Numbers may be
+// float64 or json.Number. False is returned if the values cannot be compared.
+func Compare(a, b interface{}) (int, bool) {
+	a, b = Number(a), Number(b)
+
+	switch av := a.(type) {
+	case float64:
+		bv, ok := b.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case av < bv:
+			return -1, true
+		case av > bv:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(av, bv), true
+	case bool:
+		bv, ok := b.(bool)
+		if !ok || av != bv {
+			return 0, false
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// Number converts a json.Number into a float64. Any other value is
+// returned unchanged.
+func Number(v interface{}) interface{} {
+	if n, ok := v.(json.Number); ok {
+		if f, err := n.Float64(); err == nil {
+			return f
+		}
+	}
+
+	return v
+}
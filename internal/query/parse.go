@@ -0,0 +1,228 @@
+// Package query parses apicalypse queries and evaluates them against IGDB
+// entities decoded from JSON. It backs both the igdbtest fake server and the
+// offline LocalStore.
+package query
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Errors returned when parsing an apicalypse query.
+var (
+	// ErrSyntax occurs when a query cannot be tokenized or parsed.
+	ErrSyntax = errors.New("invalid apicalypse query syntax")
+	// ErrUnknownClause occurs when a query contains a clause other than fields,
+	// exclude, where, sort, limit, offset, or search.
+	ErrUnknownClause = errors.New("unknown apicalypse clause")
+)
+
+// tokenKind identifies the type of a query token.
+type tokenKind int
+
+// Available token kinds.
+const (
+	tokIdent tokenKind = iota
+	tokNumber
+	tokString
+	tokPunct
+)
+
+// token is a single lexical element of an apicalypse query.
+type token struct {
+	kind tokenKind
+	val  string
+}
+
+// tokenize splits the provided apicalypse query into tokens.
+func tokenize(qry string) ([]token, error) {
+	var toks []token
+
+	r := []rune(qry)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			var b strings.Builder
+			i++
+			for ; i < len(r) && r[i] != '"'; i++ {
+				if r[i] == '\\' && i+1 < len(r) {
+					i++
+				}
+				b.WriteRune(r[i])
+			}
+			if i >= len(r) {
+				return nil, errors.Wrap(ErrSyntax, "unterminated string")
+			}
+			i++
+			toks = append(toks, token{kind: tokString, val: b.String()})
+		case c == '-' || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(r) && (unicode.IsDigit(r[j]) || r[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{kind: tokNumber, val: string(r[i:j])})
+			i = j
+		case isIdentRune(c):
+			j := i + 1
+			for j < len(r) && isIdentRune(r[j]) {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, val: string(r[i:j])})
+			i = j
+		case strings.ContainsRune("!<>", c) && i+1 < len(r) && r[i+1] == '=':
+			toks = append(toks, token{kind: tokPunct, val: string(r[i : i+2])})
+			i += 2
+		case strings.ContainsRune("=<>~&|()[]{},*;!", c):
+			toks = append(toks, token{kind: tokPunct, val: string(c)})
+			i++
+		default:
+			return nil, errors.Wrapf(ErrSyntax, "unexpected character %q", c)
+		}
+	}
+
+	return toks, nil
+}
+
+// isIdentRune returns true if the provided rune can be part of a field name.
+func isIdentRune(c rune) bool {
+	return c == '_' || c == '.' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// Query is a parsed apicalypse query. A negative Limit means no limit
+// was provided.
+type Query struct {
+	Fields    []string
+	Exclude   []string
+	Where     Expr
+	SortField string
+	SortDesc  bool
+	Limit     int
+	Offset    int
+	Search    string
+	HasSearch bool
+}
+
+// Parse parses the provided apicalypse query body.
+func Parse(body string) (*Query, error) {
+	toks, err := tokenize(body)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{Limit: -1}
+
+	for len(toks) > 0 {
+		end := 0
+		for end < len(toks) && !(toks[end].kind == tokPunct && toks[end].val == ";") {
+			end++
+		}
+		stmt := toks[:end]
+		if end < len(toks) {
+			end++
+		}
+		toks = toks[end:]
+
+		if len(stmt) == 0 {
+			continue
+		}
+		if err := q.parseClause(stmt[0], stmt[1:]); err != nil {
+			return nil, err
+		}
+	}
+
+	return q, nil
+}
+
+// parseClause parses the arguments of a single clause into the query.
+func (q *Query) parseClause(kw token, args []token) error {
+	if kw.kind != tokIdent {
+		return errors.Wrapf(ErrSyntax, "expected clause, got %q", kw.val)
+	}
+
+	switch strings.ToLower(kw.val) {
+	case "fields", "f":
+		q.Fields = parseFieldList(args)
+	case "exclude", "x":
+		q.Exclude = parseFieldList(args)
+	case "where", "w":
+		p := &parser{toks: args}
+		e, err := p.parseOr()
+		if err != nil {
+			return err
+		}
+		if !p.done() {
+			return errors.Wrapf(ErrSyntax, "unexpected %q in where clause", p.peek().val)
+		}
+		q.Where = e
+	case "sort", "s":
+		if len(args) < 1 || args[0].kind != tokIdent {
+			return errors.Wrap(ErrSyntax, "sort requires a field")
+		}
+		q.SortField = args[0].val
+		if len(args) > 1 {
+			q.SortDesc = strings.EqualFold(args[1].val, "desc")
+		}
+	case "limit", "l":
+		n, err := parseInt(args)
+		if err != nil {
+			return err
+		}
+		q.Limit = n
+	case "offset", "o":
+		n, err := parseInt(args)
+		if err != nil {
+			return err
+		}
+		q.Offset = n
+	case "search":
+		// search may be given an optional column before the term.
+		if len(args) < 1 || args[len(args)-1].kind != tokString {
+			return errors.Wrap(ErrSyntax, "search requires a quoted term")
+		}
+		q.Search = args[len(args)-1].val
+		q.HasSearch = true
+	default:
+		return errors.Wrapf(ErrUnknownClause, "%q", kw.val)
+	}
+
+	return nil
+}
+
+// parseFieldList returns the comma separated field names found in the
+// provided tokens.
+func parseFieldList(args []token) []string {
+	var f []string
+	for i := 0; i < len(args); i++ {
+		t := args[i]
+		switch {
+		case t.kind == tokIdent && strings.HasSuffix(t.val, ".") && i+1 < len(args) && args[i+1].val == "*":
+			// Every subfield of an expanded field (e.g. cover.*).
+			f = append(f, t.val+"*")
+			i++
+		case t.kind == tokIdent || (t.kind == tokPunct && t.val == "*"):
+			f = append(f, t.val)
+		}
+	}
+
+	return f
+}
+
+// parseInt returns the single integer found in the provided tokens.
+func parseInt(args []token) (int, error) {
+	if len(args) != 1 || args[0].kind != tokNumber {
+		return 0, errors.Wrap(ErrSyntax, "expected a single number")
+	}
+
+	n, err := strconv.Atoi(args[0].val)
+	if err != nil {
+		return 0, errors.Wrap(ErrSyntax, err.Error())
+	}
+
+	return n, nil
+}
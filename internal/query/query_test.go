@@ -0,0 +1,69 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		qry     string
+		want    *Query
+		wantErr error
+	}{
+		{"Empty", "", &Query{Limit: -1}, nil},
+		{"Fields and exclude", "fields name,slug; exclude slug;", &Query{Fields: []string{"name", "slug"}, Exclude: []string{"slug"}, Limit: -1}, nil},
+		{"Expanded fields", "fields name,cover.*,cover.url;", &Query{Fields: []string{"name", "cover.*", "cover.url"}, Limit: -1}, nil},
+		{"Pagination", "limit 5; offset 10;", &Query{Limit: 5, Offset: 10}, nil},
+		{"Sort", "sort rating desc;", &Query{SortField: "rating", SortDesc: true, Limit: -1}, nil},
+		{"Search", `search "zelda";`, &Query{Search: "zelda", HasSearch: true, Limit: -1}, nil},
+		{"Unknown clause", "frobnicate 1;", nil, ErrUnknownClause},
+		{"Unterminated string", `search "zelda;`, nil, ErrSyntax},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := Parse(test.qry)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(q, test.want) {
+				t.Errorf("got: <%+v>, want: <%+v>", q, test.want)
+			}
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	ent := Entity{"name": "The Legend of Zelda", "rating": 90.0, "genres": []interface{}{12.0, 31.0}, "cover": map[string]interface{}{"url": "x"}}
+
+	tests := []struct {
+		name  string
+		where string
+		want  bool
+	}{
+		{"Or", "rating < 50 | genres = 12", true},
+		{"Group", "(rating < 50 | genres = 12) & name = null", false},
+		{"Negation", "!(rating < 50)", true},
+		{"Case insensitive wildcard", `name ~ *"zelda"*`, true},
+		{"Prefix", `name = "The"*`, true},
+		{"Postfix", `name = *"Link"`, false},
+		{"Subfield", `cover.url = "x"`, true},
+		{"Missing field", "hypes > 0", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := Parse("where " + test.where + ";")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := q.Where.Eval(ent); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
@@ -12,15 +12,15 @@ import (
 // of a particular video game.
 // For more information visit: https://api-docs.igdb.com/#involved-company
 type InvolvedCompany struct {
-	ID         int  `json:"id"`
-	Company    int  `json:"company"`
-	CreatedAt  int  `json:"created_at"`
-	Developer  bool `json:"developer"`
-	Game       int  `json:"game"`
-	Porting    bool `json:"porting"`
-	Publisher  bool `json:"publisher"`
-	Supporting bool `json:"supporting"`
-	UpdatedAt  int  `json:"updated_at"`
+	ID         int      `json:"id"`
+	Company    int      `json:"company"`
+	CreatedAt  UnixTime `json:"created_at"`
+	Developer  bool     `json:"developer"`
+	Game       int      `json:"game"`
+	Porting    bool     `json:"porting"`
+	Publisher  bool     `json:"publisher"`
+	Supporting bool     `json:"supporting"`
+	UpdatedAt  UnixTime `json:"updated_at"`
 }
 
 // InvolvedCompanyService handles all the API calls for the IGDB InvolvedCompany endpoint.
@@ -38,6 +38,19 @@ func (ks *KeywordService) Get(id int, opts ...Option) (*Keyword, error) {
 	return key[0], nil
 }
 
+// KeywordBySlug returns a single Keyword identified by the provided slug using
+// the provided KeywordReader, such as the Keywords field of a Client. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Keywords, an error is returned.
+func KeywordBySlug(r KeywordReader, slug string, opts ...Option) (*Keyword, error) {
+	key, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Keyword with slug %s", slug)
+	}
+
+	return key[0], nil
+}
+
 // List returns a list of Keywords identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Keyword is ignored. If none of the IDs
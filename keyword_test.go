@@ -57,6 +57,49 @@ func TestKeywordService_Get(t *testing.T) {
 	}
 }
 
+func TestKeywordBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testKeywordGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Keyword, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name        string
+		file        string
+		slug        string
+		opts        []Option
+		wantKeyword *Keyword
+		wantErr     error
+	}{
+		{"Valid response", testKeywordGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			key, err := KeywordBySlug(c.Keywords, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(key, test.wantKeyword) {
+				t.Errorf("got: <%v>, \nwant: <%v>", key, test.wantKeyword)
+			}
+		})
+	}
+}
+
 func TestKeywordService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testKeywordList)
 	if err != nil {
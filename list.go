@@ -11,21 +11,21 @@ import (
 // List represents a user-created list of games.
 // For more information visit: https://api-docs.igdb.com/#list
 type List struct {
-	ID           int    `json:"id"`
-	CreatedAt    int    `json:"created_at"`
-	Description  string `json:"description"`
-	EntriesCount int    `json:"entries_count"`
-	ListEntries  []int  `json:"list_entries"`
-	ListTags     []int  `json:"list_tags"`
-	ListedGames  []int  `json:"listed_games"`
-	Name         string `json:"name"`
-	Numbering    bool   `json:"numbering"`
-	Private      bool   `json:"private"`
-	SimilarLists []int  `json:"similar_lists"`
-	Slug         string `json:"slug"`
-	UpdatedAt    int    `json:"updated_at"`
-	URL          string `json:"url"`
-	User         int    `json:"user"`
+	ID           int      `json:"id"`
+	CreatedAt    UnixTime `json:"created_at"`
+	Description  string   `json:"description"`
+	EntriesCount int      `json:"entries_count"`
+	ListEntries  []int    `json:"list_entries"`
+	ListTags     []int    `json:"list_tags"`
+	ListedGames  []int    `json:"listed_games"`
+	Name         string   `json:"name"`
+	Numbering    bool     `json:"numbering"`
+	Private      bool     `json:"private"`
+	SimilarLists []int    `json:"similar_lists"`
+	Slug         string   `json:"slug"`
+	UpdatedAt    UnixTime `json:"updated_at"`
+	URL          string   `json:"url"`
+	User         int      `json:"user"`
 }
 
 // ListService handles all the API calls for the IGDB List endpoint.
@@ -50,6 +50,19 @@ func (ls *ListService) Get(id int, opts ...Option) (*List, error) {
 	return l[0], nil
 }
 
+// ListBySlug returns a single List identified by the provided slug using the
+// provided ListReader, such as the Lists field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Lists, an error is returned.
+func ListBySlug(r ListReader, slug string, opts ...Option) (*List, error) {
+	l, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get List with slug %s", slug)
+	}
+
+	return l[0], nil
+}
+
 // List returns a list of Lists identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a List is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestListService_Get(t *testing.T) {
 	}
 }
 
+func TestListBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testListGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*List, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name     string
+		file     string
+		slug     string
+		opts     []Option
+		wantList *List
+		wantErr  error
+	}{
+		{"Valid response", testListGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			l, err := ListBySlug(c.Lists, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(l, test.wantList) {
+				t.Errorf("got: <%v>, \nwant: <%v>", l, test.wantList)
+			}
+		})
+	}
+}
+
 func TestListService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testListList)
 	if err != nil {
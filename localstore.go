@@ -0,0 +1,420 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gotomgo/igdb/internal/query"
+	"github.com/pkg/errors"
+)
+
+// DefaultIndexFields are the fields indexed by a LocalStore when no fields
+// are provided. The id field is always indexed.
+var DefaultIndexFields = []string{"platforms", "genres", "first_release_date"}
+
+// DefaultLocalMaxLimit is the largest limit accepted by a new LocalStore.
+const DefaultLocalMaxLimit = 5000
+
+// LocalStore answers IGDB API requests offline using the entities mirrored
+// into a JSONLStore directory by a Syncer. LocalStore is an http.RoundTripper,
+// so a Client created with its HTTP client evaluates every service call
+// (e.g. Games.Index or Games.List) against the local entities instead of the
+// IGDB. The same functional options, such as SetFilter, SetOrder, and
+// SetLimit, are honored.
+//
+// Each endpoint is loaded into memory on first use along with secondary
+// indexes on the numeric fields chosen when the LocalStore was created.
+// Filters on indexed fields narrow the entities scanned by a query.
+//
+// As with the IGDB API, only the ID of an entity is returned unless other
+// fields are requested with SetFields.
+type LocalStore struct {
+	// MaxLimit is the largest limit a query may request.
+	MaxLimit int
+
+	store  *JSONLStore
+	fields []string
+	mu     sync.Mutex
+	ends   map[string]*localEndpoint
+}
+
+// NewLocalStore returns a LocalStore reading the JSONLStore in the provided
+// directory and indexing the provided fields. If no fields are provided,
+// DefaultIndexFields are indexed.
+func NewLocalStore(dir string, fields ...string) (*LocalStore, error) {
+	st, err := NewJSONLStore(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		fields = DefaultIndexFields
+	}
+
+	return &LocalStore{
+		MaxLimit: DefaultLocalMaxLimit,
+		store:    st,
+		fields:   append([]string{"id"}, fields...),
+		ends:     make(map[string]*localEndpoint),
+	}, nil
+}
+
+// Client returns an HTTP client whose requests are answered by the LocalStore.
+// Pass it to NewClient to use the services offline.
+func (ls *LocalStore) Client() *http.Client {
+	return &http.Client{Transport: ls}
+}
+
+// Refresh discards every loaded endpoint so that entities synced since
+// they were loaded are read on next use.
+func (ls *LocalStore) Refresh() error {
+	st, err := NewJSONLStore(ls.store.dir)
+	if err != nil {
+		return err
+	}
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	ls.store = st
+	ls.ends = make(map[string]*localEndpoint)
+
+	return nil
+}
+
+// RoundTrip fulfills the http.RoundTripper interface.
+func (ls *LocalStore) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read request body")
+		}
+		body = b
+	}
+
+	status, res := ls.serve(strings.TrimPrefix(req.URL.Path, "/"), string(body))
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode local response")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+// serve evaluates the provided query against the endpoint at the provided
+// path and returns the status and value of the response.
+func (ls *LocalStore) serve(path, body string) (int, interface{}) {
+	qry, err := query.Parse(body)
+	if err != nil {
+		return http.StatusBadRequest, ServerError{Status: http.StatusBadRequest, Msg: err.Error()}
+	}
+
+	var sub string
+	for _, s := range []string{"count", "meta"} {
+		if strings.HasSuffix(path, "/"+s) {
+			path, sub = strings.TrimSuffix(path, s), s
+		}
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+
+	le, err := ls.endpoint(path)
+	if err != nil {
+		return http.StatusInternalServerError, ServerError{Status: http.StatusInternalServerError, Msg: err.Error()}
+	}
+
+	switch sub {
+	case "count":
+		return http.StatusOK, Count{Count: len(qry.Filter(le.candidates(qry.Where)))}
+	case "meta":
+		return http.StatusOK, query.FieldNames(le.ents)
+	}
+
+	res, err := qry.Run(le.candidates(qry.Where), ls.MaxLimit)
+	if err != nil {
+		return http.StatusBadRequest, ServerError{Status: http.StatusBadRequest, Msg: err.Error()}
+	}
+
+	return http.StatusOK, res
+}
+
+// endpoint returns the loaded entities of the provided endpoint, loading
+// and indexing them on first use.
+func (ls *LocalStore) endpoint(end string) (*localEndpoint, error) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if le, ok := ls.ends[end]; ok {
+		return le, nil
+	}
+
+	recs, err := ls.store.All(end)
+	if err != nil {
+		return nil, err
+	}
+
+	le := &localEndpoint{
+		ents: make([]query.Entity, len(recs)),
+		idx:  make(map[string]*localIndex),
+	}
+	for i, r := range recs {
+		if le.ents[i], err = query.DecodeOne(r.Data); err != nil {
+			return nil, errors.Wrap(errInvalidJSON, err.Error())
+		}
+	}
+	for _, f := range ls.fields {
+		le.idx[f] = newLocalIndex(le.ents, f)
+	}
+
+	ls.ends[end] = le
+	return le, nil
+}
+
+// localEndpoint holds the entities of a single endpoint ordered by ID,
+// along with their secondary indexes.
+type localEndpoint struct {
+	ents []query.Entity
+	idx  map[string]*localIndex
+}
+
+// candidates returns the entities that may satisfy the provided where
+// clause, in ID order. Every entity is returned when the clause cannot be
+// answered by an index.
+func (le *localEndpoint) candidates(where query.Expr) []query.Entity {
+	pos, ok := le.plan(where)
+	if !ok {
+		return le.ents
+	}
+
+	ents := make([]query.Entity, len(pos))
+	for i, p := range pos {
+		ents[i] = le.ents[p]
+	}
+
+	return ents
+}
+
+// plan returns the sorted positions of the entities that may satisfy the
+// provided expression using only indexes. False is returned if the
+// expression cannot be answered by an index.
+func (le *localEndpoint) plan(e query.Expr) ([]int, bool) {
+	switch e := e.(type) {
+	case query.And:
+		l, lok := le.plan(e.L)
+		r, rok := le.plan(e.R)
+		switch {
+		case lok && rok:
+			return intersect(l, r), true
+		case lok:
+			return l, true
+		case rok:
+			return r, true
+		}
+	case query.Or:
+		l, lok := le.plan(e.L)
+		r, rok := le.plan(e.R)
+		if lok && rok {
+			return union(l, r), true
+		}
+	case *query.Cond:
+		return le.planCond(e)
+	}
+
+	return nil, false
+}
+
+// planCond returns the positions of the entities that may satisfy the
+// provided condition using the index on its field.
+func (le *localEndpoint) planCond(c *query.Cond) ([]int, bool) {
+	idx, ok := le.idx[c.Field]
+	if !ok || c.Prefix || c.Suffix {
+		return nil, false
+	}
+
+	if len(c.Vals) == 0 {
+		return nil, false
+	}
+
+	keys := make([]float64, len(c.Vals))
+	for i, v := range c.Vals {
+		f, ok := v.(float64)
+		if !ok {
+			return nil, false
+		}
+		keys[i] = f
+	}
+
+	switch c.Op {
+	case "=":
+		var pos []int
+		for i, k := range keys {
+			switch {
+			case c.List == query.ListAny || c.List == query.ListNone:
+				pos = union(pos, idx.pos[k])
+			case i == 0:
+				pos = idx.pos[k]
+			default:
+				pos = intersect(pos, idx.pos[k])
+			}
+		}
+		return pos, true
+	case ">", ">=", "<", "<=":
+		if c.List != query.ListNone {
+			return nil, false
+		}
+		return idx.rangeOf(c.Op, keys[0]), true
+	}
+
+	return nil, false
+}
+
+// localIndex maps the numeric values of a single field to the positions of
+// the entities holding them. Array fields are indexed by each element.
+type localIndex struct {
+	keys []float64
+	pos  map[float64][]int
+}
+
+// newLocalIndex indexes the provided field of the provided entities.
+func newLocalIndex(ents []query.Entity, field string) *localIndex {
+	idx := &localIndex{pos: make(map[float64][]int)}
+
+	add := func(v interface{}, p int) {
+		f, ok := query.Number(v).(float64)
+		if !ok {
+			return
+		}
+		ps := idx.pos[f]
+		if len(ps) > 0 && ps[len(ps)-1] == p {
+			return
+		}
+		if len(ps) == 0 {
+			idx.keys = append(idx.keys, f)
+		}
+		idx.pos[f] = append(ps, p)
+	}
+
+	for p, e := range ents {
+		v, ok := e.Lookup(field)
+		if !ok {
+			continue
+		}
+		if arr, ok := v.([]interface{}); ok {
+			for _, el := range arr {
+				add(el, p)
+			}
+			continue
+		}
+		add(v, p)
+	}
+
+	sort.Float64s(idx.keys)
+
+	return idx
+}
+
+// rangeOf returns the sorted positions of the entities whose value
+// satisfies the provided comparison against k.
+func (idx *localIndex) rangeOf(op string, k float64) []int {
+	i := sort.SearchFloat64s(idx.keys, k)
+
+	var keys []float64
+	switch op {
+	case ">":
+		if i < len(idx.keys) && idx.keys[i] == k {
+			i++
+		}
+		keys = idx.keys[i:]
+	case ">=":
+		keys = idx.keys[i:]
+	case "<":
+		keys = idx.keys[:i]
+	case "<=":
+		if i < len(idx.keys) && idx.keys[i] == k {
+			i++
+		}
+		keys = idx.keys[:i]
+	}
+
+	var pos []int
+	for _, k := range keys {
+		pos = append(pos, idx.pos[k]...)
+	}
+	sort.Ints(pos)
+
+	return dedupe(pos)
+}
+
+// union returns the sorted union of two sorted position lists.
+func union(a, b []int) []int {
+	out := make([]int, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j >= len(b) || (i < len(a) && a[i] < b[j]):
+			out = append(out, a[i])
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			out = append(out, b[j])
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+
+	return out
+}
+
+// intersect returns the sorted intersection of two sorted position lists.
+func intersect(a, b []int) []int {
+	var out []int
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+
+	return out
+}
+
+// dedupe removes adjacent duplicates from a sorted position list.
+func dedupe(pos []int) []int {
+	out := pos[:0]
+	for i, p := range pos {
+		if i == 0 || p != pos[i-1] {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,150 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/gotomgo/igdb/internal/query"
+	"github.com/pkg/errors"
+)
+
+// newTestLocalStore returns a LocalStore holding a few mirrored Games.
+func newTestLocalStore(t *testing.T) (*LocalStore, func()) {
+	dir, err := ioutil.TempDir("", "localstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	games := []string{
+		`{"id": 1, "name": "Alpha", "platforms": [6, 48], "genres": [12], "first_release_date": 1000}`,
+		`{"id": 2, "name": "Beta", "platforms": [48], "genres": [5, 12], "first_release_date": 3000}`,
+		`{"id": 3, "name": "Gamma", "platforms": [6], "genres": [31], "first_release_date": 2000}`,
+		`{"id": 4, "name": "Delta", "platforms": [130]}`,
+	}
+	recs, err := newSyncRecords(rawMessages(games))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := st.Put(string(EndpointGame), recs); err != nil {
+		t.Fatal(err)
+	}
+
+	ls, err := NewLocalStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ls, func() { os.RemoveAll(dir) }
+}
+
+// rawMessages converts the provided strings into raw JSON messages.
+func rawMessages(s []string) []json.RawMessage {
+	raw := make([]json.RawMessage, len(s))
+	for i, v := range s {
+		raw[i] = json.RawMessage(v)
+	}
+
+	return raw
+}
+
+func TestLocalStore_Games(t *testing.T) {
+	ls, cleanup := newTestLocalStore(t)
+	defer cleanup()
+
+	c := NewClient("", ls.Client())
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantIDs []int
+		wantErr error
+	}{
+		{"Platform filter", []Option{SetFilter("platforms", OpEquals, "48")}, []int{1, 2}, nil},
+		{"Genre and date filters", []Option{SetFilter("genres", OpContainsAtLeast, "12", "31"), SetFilter("first_release_date", OpGreaterThan, "1000")}, []int{2, 3}, nil},
+		{"Date order", []Option{SetOrder("first_release_date", OrderDescending), SetLimit(3)}, []int{2, 3, 1}, nil},
+		{"Unindexed filter", []Option{SetFilter("name", OpEquals, `"Delta"`)}, []int{4}, nil},
+		{"No results", []Option{SetFilter("platforms", OpEquals, "1")}, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g, err := c.Games.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var ids []int
+			for _, v := range g {
+				ids = append(ids, v.ID)
+			}
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", ids, test.wantIDs)
+			}
+		})
+	}
+
+	g, err := c.Games.List([]int{3, 1}, SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g) != 2 || g[0].Name != "Alpha" || g[1].Name != "Gamma" {
+		t.Errorf("got: <%v>, want Alpha and Gamma", g)
+	}
+
+	ct, err := c.Games.Count(SetFilter("platforms", OpEquals, "6"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct != 2 {
+		t.Errorf("got: <%v>, want: <%v>", ct, 2)
+	}
+}
+
+func TestLocalEndpoint_Plan(t *testing.T) {
+	ls, cleanup := newTestLocalStore(t)
+	defer cleanup()
+
+	le, err := ls.endpoint(string(EndpointGame))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		where   string
+		wantPos []int
+		wantOK  bool
+	}{
+		{"Equals", "platforms = 6", []int{0, 2}, true},
+		{"Contains at least", "genres = (5,31)", []int{1, 2}, true},
+		{"Contains all", "platforms = [6,48]", []int{0}, true},
+		{"Range", "first_release_date <= 2000", []int{0, 2}, true},
+		{"And with unindexed", `platforms = 48 & name = "Beta"`, []int{0, 1}, true},
+		{"Or", "platforms = 130 | genres = 31", []int{2, 3}, true},
+		{"Or with unindexed", `platforms = 130 | name = "Beta"`, nil, false},
+		{"Not equals", "platforms != 6", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := query.Parse("where " + test.where + ";")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pos, ok := le.plan(q.Where)
+			if ok != test.wantOK {
+				t.Errorf("got: <%v>, want: <%v>", ok, test.wantOK)
+			}
+			if !reflect.DeepEqual(pos, test.wantPos) {
+				t.Errorf("got: <%v>, want: <%v>", pos, test.wantPos)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package igdb
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Call describes a single IGDB API call as it passes through a Client's
+// middleware. Middleware may inspect or modify the Call before passing it
+// on, and may inspect Result once the next Handler returns.
+type Call struct {
+	// Endpoint is the endpoint being called, including any count or meta
+	// suffix (e.g. games/count).
+	Endpoint endpoint
+	// Query is the apicalypse query sent as the request body.
+	Query string
+	// Request is the HTTP request to be sent. Its body is replaced with
+	// Query each time the request is sent.
+	Request *http.Request
+	// Result points to the value the response is decoded into.
+	Result interface{}
+	// Attempts is the number of times the request has been sent. It is
+	// zero if the Call was answered without sending the request, such as
+	// from a cache.
+	Attempts int
+	// StatusCode is the HTTP status code of the latest response received.
+	StatusCode int
+	// BytesReceived is the total size of the response bodies received.
+	BytesReceived int64
+}
+
+// Handler performs a Call, storing the decoded response in the Call's Result.
+type Handler func(call *Call) error
+
+// Middleware wraps a Handler with additional behavior such as logging,
+// caching, or retrying. A Middleware must call next to send the request.
+type Middleware func(next Handler) Handler
+
+// Chain composes the provided middleware into a single Middleware. The first
+// middleware is the outermost; it sees each Call first and its result last.
+func Chain(mw ...Middleware) Middleware {
+	return func(next Handler) Handler {
+		for i := len(mw) - 1; i >= 0; i-- {
+			next = mw[i](next)
+		}
+		return next
+	}
+}
+
+// Use appends the provided middleware to the Client's middleware chain.
+// Middleware is applied in the order it is added, so the first middleware
+// added is the outermost.
+func (c *Client) Use(mw ...Middleware) {
+	c.middleware = append(c.middleware, mw...)
+}
+
+// IsTemporary reports whether the provided error is likely to succeed if the
+// call is retried. Network errors and server errors with a 429 or 5xx status
+// are considered temporary.
+func IsTemporary(err error) bool {
+	switch e := errors.Cause(err).(type) {
+	case ServerError:
+		return e.Status == http.StatusTooManyRequests || e.Status >= http.StatusInternalServerError
+	case net.Error:
+		return true
+	}
+
+	return false
+}
+
+// Retry returns a Middleware that retries a Call up to max times when it
+// fails with a temporary error as reported by IsTemporary. The delay before
+// each retry starts at backoff and doubles after every attempt.
+func Retry(max int, backoff time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) error {
+			err := next(call)
+			for i := 0; i < max && IsTemporary(err); i++ {
+				time.Sleep(backoff << uint(i))
+				err = next(call)
+			}
+			return err
+		}
+	}
+}
+
+// RefreshKey returns a Middleware that calls refresh to obtain a new API key
+// when a Call is rejected as unauthorized or forbidden. The Call is retried
+// once with the new key, which is also used for every later Call.
+func RefreshKey(refresh func() (string, error)) Middleware {
+	var (
+		mu  sync.Mutex
+		key string
+	)
+
+	return func(next Handler) Handler {
+		return func(call *Call) error {
+			mu.Lock()
+			if key != "" {
+				call.Request.Header.Set("user-key", key)
+			}
+			mu.Unlock()
+
+			err := next(call)
+			if c := errors.Cause(err); c != ErrUnauthorized && c != ErrForbidden {
+				return err
+			}
+
+			k, rerr := refresh()
+			if rerr != nil {
+				return errors.Wrap(rerr, "cannot refresh API key")
+			}
+
+			mu.Lock()
+			key = k
+			mu.Unlock()
+
+			call.Request.Header.Set("user-key", k)
+			return next(call)
+		}
+	}
+}
+
+// cacheEntry is a single response held by the Cache middleware.
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// Cache returns a Middleware that keeps successful responses in memory for
+// the provided duration. Calls to the same endpoint with the same query are
+// answered from the cache until the response expires. Responses that cannot
+// be encoded as JSON are never cached.
+func Cache(ttl time.Duration) Middleware {
+	var (
+		mu      sync.Mutex
+		entries = make(map[string]cacheEntry)
+	)
+
+	return func(next Handler) Handler {
+		return func(call *Call) error {
+			key := string(call.Endpoint) + "\n" + call.Query
+
+			mu.Lock()
+			ent, ok := entries[key]
+			if ok && time.Now().After(ent.expires) {
+				delete(entries, key)
+				ok = false
+			}
+			mu.Unlock()
+
+			if ok {
+				if err := json.Unmarshal(ent.data, call.Result); err != nil {
+					return errors.Wrap(errInvalidJSON, err.Error())
+				}
+				return nil
+			}
+
+			if err := next(call); err != nil {
+				return err
+			}
+
+			// The call itself succeeded, so a result that cannot be
+			// encoded is returned to the caller without being cached.
+			b, err := json.Marshal(call.Result)
+			if err != nil {
+				return nil
+			}
+
+			mu.Lock()
+			entries[key] = cacheEntry{data: b, expires: time.Now().Add(ttl)}
+			mu.Unlock()
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package igdb
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// testCountingServer starts a test server that responds to the nth request
+// (starting at 1) using the provided function. It returns a Client
+// configured for the server and a pointer to the request count.
+func testCountingServer(respond func(n int32, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *Client, *int32) {
+	var n int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respond(atomic.AddInt32(&n, 1), w, r)
+	}))
+
+	c := NewClient(testKey, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c, &n
+}
+
+func TestChain(t *testing.T) {
+	var got []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(call *Call) error {
+				got = append(got, name+" in")
+				err := next(call)
+				got = append(got, name+" out")
+				return err
+			}
+		}
+	}
+
+	h := Chain(trace("a"), trace("b"))(func(call *Call) error {
+		got = append(got, "send")
+		return nil
+	})
+	if err := h(&Call{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"a in", "b in", "send", "b out", "a out"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestClient_Use(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, testResult)
+	defer ts.Close()
+
+	var seen Call
+	c.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			err := next(call)
+			seen = *call
+			return err
+		}
+	})
+
+	var res testResultPlaceholder
+	if err := c.get(testEndpoint, &res, SetLimit(15)); err != nil {
+		t.Fatal(err)
+	}
+
+	if seen.Endpoint != testEndpoint {
+		t.Errorf("got: <%v>, want: <%v>", seen.Endpoint, testEndpoint)
+	}
+	if seen.Query != "limit 15; " {
+		t.Errorf("got: <%v>, want: <%v>", seen.Query, "limit 15; ")
+	}
+	if seen.Request == nil || seen.Result != &res || res.SomeField != "some_value" {
+		t.Errorf("got: <%+v>, want request and decoded result", seen)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int32
+		status    int
+		wantCalls int32
+		wantErr   error
+	}{
+		{"No failures", 0, http.StatusInternalServerError, 1, nil},
+		{"Recovers", 2, http.StatusInternalServerError, 3, nil},
+		{"Exhausted", 5, http.StatusInternalServerError, 4, ErrInternalError},
+		{"Not temporary", 2, http.StatusBadRequest, 1, ErrBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, n := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+				if n <= test.failures {
+					w.WriteHeader(test.status)
+					return
+				}
+				fmt.Fprint(w, testResult)
+			})
+			defer ts.Close()
+			c.Use(Retry(3, time.Millisecond))
+
+			var res testResultPlaceholder
+			err := c.get(testEndpoint, &res, SetLimit(5))
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if *n != test.wantCalls {
+				t.Errorf("got: <%v> calls, want: <%v>", *n, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRefreshKey(t *testing.T) {
+	ts, c, n := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("user-key") != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, testResult)
+	})
+	defer ts.Close()
+
+	c.Use(RefreshKey(func() (string, error) { return "fresh", nil }))
+
+	for i := 0; i < 2; i++ {
+		var res testResultPlaceholder
+		if err := c.get(testEndpoint, &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first call is retried once and the second uses the refreshed key.
+	if *n != 3 {
+		t.Errorf("got: <%v> calls, want: <%v>", *n, 3)
+	}
+}
+
+func TestCache(t *testing.T) {
+	ts, c, n := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testResult)
+	})
+	defer ts.Close()
+
+	c.Use(Cache(time.Minute))
+
+	for _, opt := range []Option{SetLimit(5), SetLimit(5), SetLimit(6)} {
+		var res testResultPlaceholder
+		if err := c.get(testEndpoint, &res, opt); err != nil {
+			t.Fatal(err)
+		}
+		if res.SomeField != "some_value" {
+			t.Errorf("got: <%v>, want: <%v>", res.SomeField, "some_value")
+		}
+	}
+
+	if *n != 2 {
+		t.Errorf("got: <%v> calls, want: <%v>", *n, 2)
+	}
+}
+
+func TestIsTemporary(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"Nil", nil, false},
+		{"Internal error", errors.Wrap(ErrInternalError, "wrapped"), true},
+		{"Too many requests", ServerError{Status: http.StatusTooManyRequests}, true},
+		{"Bad request", ErrBadRequest, false},
+		{"No results", ErrNoResults, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsTemporary(test.err); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Henry-Sarabia/apicalypse"
 	"github.com/Henry-Sarabia/blank"
 	"github.com/pkg/errors"
+	"strconv"
 	"strings"
 )
 
@@ -139,10 +140,6 @@ func SetFields(fields ...string) Option {
 			if blank.Is(f) {
 				return nil, ErrEmptyFields
 			}
-
-			if strings.Contains(f, ".") {
-				return nil, ErrExpandedField
-			}
 		}
 
 		return apicalypse.Fields(fields...), nil
@@ -236,6 +233,38 @@ func SetFilter(field string, op operator, val ...string) Option {
 	}
 }
 
+// WithTags is a functional option used to filter the results from an API
+// call by their tags. Since a single Tag can address a genre, theme,
+// keyword, game, or player perspective, WithTags can match objects by any
+// combination of them in a single filter. Generate the Tags using
+// GenerateTag. For example, the following matches Games with the genre 12 or
+// the theme 1:
+//
+//	genre, _ := GenerateTag(TagGenre, 12)
+//	theme, _ := GenerateTag(TagTheme, 1)
+//	WithTags(OpContainsAtLeast, genre, theme)
+//
+// If no Tags are provided, an error is returned.
+//
+// For more information, visit: https://api-docs.igdb.com/#tag-numbers
+func WithTags(op operator, tags ...Tag) Option {
+	return func() (apicalypse.Option, error) {
+		if len(tags) <= 0 {
+			return nil, ErrEmptyFilterVals
+		}
+
+		vals := make([]string, len(tags))
+		for i, t := range tags {
+			if t < 0 {
+				return nil, ErrNegativeID
+			}
+			vals[i] = t.String()
+		}
+
+		return SetFilter("tags", op, vals...)()
+	}
+}
+
 // setSearch is a functional option used to search the IGDB using the
 // provided query.
 func setSearch(qry string) Option {
@@ -247,3 +276,15 @@ func setSearch(qry string) Option {
 		return apicalypse.Search("", qry), nil
 	}
 }
+
+// setSlug is a functional option used to filter the results from an API
+// call by the provided slug.
+func setSlug(slug string) Option {
+	return func() (apicalypse.Option, error) {
+		if blank.Is(slug) {
+			return nil, ErrEmptySlug
+		}
+
+		return SetFilter("slug", OpEquals, strconv.Quote(slug))()
+	}
+}
@@ -233,8 +233,9 @@ func TestSetFields(t *testing.T) {
 		{"Single empty field", []string{"  "}, "", ErrEmptyFields},
 		{"Multiple empty fields", []string{"", " ", "", ""}, "", ErrEmptyFields},
 		{"Mixed empty and non-empty fields", []string{"", "id", "  ", "url"}, "", ErrEmptyFields},
-		{"Single expanded field", []string{"game.name"}, "", ErrExpandedField},
-		{"Multiple expanded fields", []string{"game.name", "game.id"}, "", ErrExpandedField},
+		{"Single expanded field", []string{"game.name"}, "game.name", nil},
+		{"Multiple expanded fields", []string{"game.name", "game.id"}, "game.name,game.id", nil},
+		{"Every expanded subfield", []string{"*", "cover.*"}, "*,cover.*", nil},
 	}
 
 	for _, test := range tests {
@@ -341,6 +342,43 @@ func TestSetFilter(t *testing.T) {
 	}
 }
 
+func TestWithTags(t *testing.T) {
+	var tests = []struct {
+		name       string
+		op         operator
+		tags       []Tag
+		wantFilter string
+		wantErr    error
+	}{
+		{"Single tag", OpEquals, []Tag{268435468}, "where tags = 268435468", nil},
+		{"Multiple tags", OpContainsAtLeast, []Tag{268435468, 1, 536870917}, "where tags = (268435468,1,536870917)", nil},
+		{"Contains all", OpContainsAll, []Tag{805306375, 1073741826}, "where tags = [805306375,1073741826]", nil},
+		{"Invalid tag", OpContainsAtLeast, []Tag{268435468, -1}, "", ErrNegativeID},
+		{"No tags", OpContainsAtLeast, nil, "", ErrEmptyFilterVals},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := WithTags(test.op, test.tags...)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
+
 func TestSetSearch(t *testing.T) {
 	var tests = []struct {
 		name    string
@@ -376,6 +414,41 @@ func TestSetSearch(t *testing.T) {
 	}
 }
 
+func TestSetSlug(t *testing.T) {
+	var tests = []struct {
+		name       string
+		slug       string
+		wantFilter string
+		wantErr    error
+	}{
+		{"Non-empty slug", "the-witcher-3-wild-hunt", `where slug = "the-witcher-3-wild-hunt"`, nil},
+		{"Slug with quotes", `say "hi"`, `where slug = "say \"hi\""`, nil},
+		{"Blank slug", " ", "", ErrEmptySlug},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := setSlug(test.slug)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
+
 func ExampleComposeOptions() {
 	c := NewClient("YOUR_API_KEY", nil)
 
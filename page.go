@@ -19,7 +19,7 @@ type Page struct {
 	Color            PageColor       `json:"color"`
 	Company          int             `json:"company"`
 	Country          int             `json:"country"`
-	CreatedAt        int             `json:"created_at"`
+	CreatedAt        UnixTime        `json:"created_at"`
 	Description      string          `json:"description"`
 	Feed             int             `json:"feed"`
 	Game             int             `json:"game"`
@@ -29,7 +29,7 @@ type Page struct {
 	PageLogo         int             `json:"page_logo"`
 	Slug             string          `json:"slug"`
 	SubCategory      PageSubCategory `json:"sub_category"`
-	UpdatedAt        int             `json:"updated_at"`
+	UpdatedAt        UnixTime        `json:"updated_at"`
 	Uplay            string          `json:"uplay"`
 	URL              string          `json:"url"`
 	User             int             `json:"user"`
@@ -97,6 +97,19 @@ func (ps *PageService) Get(id int, opts ...Option) (*Page, error) {
 	return pg[0], nil
 }
 
+// PageBySlug returns a single Page identified by the provided slug using the
+// provided PageReader, such as the Pages field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Pages, an error is returned.
+func PageBySlug(r PageReader, slug string, opts ...Option) (*Page, error) {
+	pg, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Page with slug %s", slug)
+	}
+
+	return pg[0], nil
+}
+
 // List returns a list of Pages identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Page is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestPageService_Get(t *testing.T) {
 	}
 }
 
+func TestPageBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPageGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Page, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name     string
+		file     string
+		slug     string
+		opts     []Option
+		wantPage *Page
+		wantErr  error
+	}{
+		{"Valid response", testPageGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pg, err := PageBySlug(c.Pages, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pg, test.wantPage) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pg, test.wantPage)
+			}
+		})
+	}
+}
+
 func TestPageService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPageList)
 	if err != nil {
@@ -19,7 +19,7 @@ type Person struct {
 	Country       int             `json:"country"`
 	CreditedGames []int           `json:"credited_games"`
 	Description   string          `json:"description"`
-	DOB           int             `json:"dob"`
+	DOB           UnixTime        `json:"dob"`
 	Gender        CharacterGender `json:"gender"`
 	LovesCount    int             `json:"loves_count"`
 	MugShot       int             `json:"mug_shot"`
@@ -51,6 +51,19 @@ func (ps *PersonService) Get(id int, opts ...Option) (*Person, error) {
 	return p[0], nil
 }
 
+// PersonBySlug returns a single Person identified by the provided slug using
+// the provided PersonReader, such as the Persons field of a Client. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any People, an error is returned.
+func PersonBySlug(r PersonReader, slug string, opts ...Option) (*Person, error) {
+	p, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Person with slug %s", slug)
+	}
+
+	return p[0], nil
+}
+
 // List returns a list of People identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Person is ignored. If none of the IDs
@@ -58,6 +58,49 @@ func TestPersonService_Get(t *testing.T) {
 	}
 }
 
+func TestPersonBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPersonGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Person, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name       string
+		file       string
+		slug       string
+		opts       []Option
+		wantPerson *Person
+		wantErr    error
+	}{
+		{"Valid response", testPersonGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			p, err := PersonBySlug(c.Persons, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(p, test.wantPerson) {
+				t.Errorf("got: <%v>, \nwant: <%v>", p, test.wantPerson)
+			}
+		})
+	}
+}
+
 func TestPersonService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPersonList)
 	if err != nil {
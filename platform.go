@@ -63,6 +63,19 @@ func (ps *PlatformService) Get(id int, opts ...Option) (*Platform, error) {
 	return plat[0], nil
 }
 
+// PlatformBySlug returns a single Platform identified by the provided slug
+// using the provided PlatformReader, such as the Platforms field of a Client.
+// Provide the SetFields functional option if you need to specify which fields
+// to retrieve. If the slug does not match any Platforms, an error is returned.
+func PlatformBySlug(r PlatformReader, slug string, opts ...Option) (*Platform, error) {
+	plat, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Platform with slug %s", slug)
+	}
+
+	return plat[0], nil
+}
+
 // List returns a list of Platforms identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Platform is ignored. If none of the IDs
@@ -58,6 +58,49 @@ func TestPlatformService_Get(t *testing.T) {
 	}
 }
 
+func TestPlatformBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Platform, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name         string
+		file         string
+		slug         string
+		opts         []Option
+		wantPlatform *Platform
+		wantErr      error
+	}{
+		{"Valid response", testPlatformGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			plat, err := PlatformBySlug(c.Platforms, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(plat, test.wantPlatform) {
+				t.Errorf("got: <%v>, \nwant: <%v>", plat, test.wantPlatform)
+			}
+		})
+	}
+}
+
 func TestPlatformService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlatformList)
 	if err != nil {
@@ -54,6 +54,20 @@ func (ps *PlatformVersionService) Get(id int, opts ...Option) (*PlatformVersion,
 	return ver[0], nil
 }
 
+// PlatformVersionBySlug returns a single PlatformVersion identified by the
+// provided slug using the provided PlatformVersionReader, such as the
+// PlatformVersions field of a Client. Provide the SetFields functional option
+// if you need to specify which fields to retrieve. If the slug does not match
+// any PlatformVersions, an error is returned.
+func PlatformVersionBySlug(r PlatformVersionReader, slug string, opts ...Option) (*PlatformVersion, error) {
+	ver, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlatformVersion with slug %s", slug)
+	}
+
+	return ver[0], nil
+}
+
 // List returns a list of PlatformVersions identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a PlatformVersion is ignored. If none of the IDs
@@ -57,6 +57,49 @@ func TestPlatformVersionService_Get(t *testing.T) {
 	}
 }
 
+func TestPlatformVersionBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformVersionGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PlatformVersion, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name                string
+		file                string
+		slug                string
+		opts                []Option
+		wantPlatformVersion *PlatformVersion
+		wantErr             error
+	}{
+		{"Valid response", testPlatformVersionGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ver, err := PlatformVersionBySlug(c.PlatformVersions, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ver, test.wantPlatformVersion) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ver, test.wantPlatformVersion)
+			}
+		})
+	}
+}
+
 func TestPlatformVersionService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlatformVersionList)
 	if err != nil {
@@ -14,13 +14,13 @@ import (
 type PlatformVersionReleaseDate struct {
 	ID              int            `json:"id"`
 	Category        DateCategory   `json:"category"`
-	CreatedAt       int            `json:"created_at"`
-	Date            int            `json:"date"`
+	CreatedAt       UnixTime       `json:"created_at"`
+	Date            UnixTime       `json:"date"`
 	Human           string         `json:"human"`
 	M               int            `json:"m"`
 	PlatformVersion int            `json:"platform_version"`
 	Region          RegionCategory `json:"region"`
-	UpdatedAt       int            `json:"updated_at"`
+	UpdatedAt       UnixTime       `json:"updated_at"`
 	Y               int            `json:"y"`
 }
 
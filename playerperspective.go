@@ -37,6 +37,20 @@ func (ps *PlayerPerspectiveService) Get(id int, opts ...Option) (*PlayerPerspect
 	return pp[0], nil
 }
 
+// PlayerPerspectiveBySlug returns a single PlayerPerspective identified by the
+// provided slug using the provided PlayerPerspectiveReader, such as the
+// PlayerPerspectives field of a Client. Provide the SetFields functional
+// option if you need to specify which fields to retrieve. If the slug does not
+// match any PlayerPerspectives, an error is returned.
+func PlayerPerspectiveBySlug(r PlayerPerspectiveReader, slug string, opts ...Option) (*PlayerPerspective, error) {
+	pp, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlayerPerspective with slug %s", slug)
+	}
+
+	return pp[0], nil
+}
+
 // List returns a list of PlayerPerspectives identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a PlayerPerspective is ignored. If none of the IDs
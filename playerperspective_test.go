@@ -57,6 +57,49 @@ func TestPlayerPerspectiveService_Get(t *testing.T) {
 	}
 }
 
+func TestPlayerPerspectiveBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlayerPerspectiveGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PlayerPerspective, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name                  string
+		file                  string
+		slug                  string
+		opts                  []Option
+		wantPlayerPerspective *PlayerPerspective
+		wantErr               error
+	}{
+		{"Valid response", testPlayerPerspectiveGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pp, err := PlayerPerspectiveBySlug(c.PlayerPerspectives, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pp, test.wantPlayerPerspective) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pp, test.wantPlayerPerspective)
+			}
+		})
+	}
+}
+
 func TestPlayerPerspectiveService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlayerPerspectiveList)
 	if err != nil {
@@ -39,6 +39,20 @@ func (ps *ProductFamilyService) Get(id int, opts ...Option) (*ProductFamily, err
 	return fam[0], nil
 }
 
+// ProductFamilyBySlug returns a single ProductFamily identified by the
+// provided slug using the provided ProductFamilyReader, such as the
+// ProductFamilies field of a Client. Provide the SetFields functional option
+// if you need to specify which fields to retrieve. If the slug does not match
+// any ProductFamilies, an error is returned.
+func ProductFamilyBySlug(r ProductFamilyReader, slug string, opts ...Option) (*ProductFamily, error) {
+	fam, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ProductFamily with slug %s", slug)
+	}
+
+	return fam[0], nil
+}
+
 // List returns a list of ProductFamilies identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a ProductFamily is ignored. If none of the IDs
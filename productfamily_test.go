@@ -57,6 +57,49 @@ func TestProductFamilyService_Get(t *testing.T) {
 	}
 }
 
+func TestProductFamilyBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testProductFamilyGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*ProductFamily, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name              string
+		file              string
+		slug              string
+		opts              []Option
+		wantProductFamily *ProductFamily
+		wantErr           error
+	}{
+		{"Valid response", testProductFamilyGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fam, err := ProductFamilyBySlug(c.ProductFamilies, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fam, test.wantProductFamily) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fam, test.wantProductFamily)
+			}
+		})
+	}
+}
+
 func TestProductFamilyService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testProductFamilyList)
 	if err != nil {
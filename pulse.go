@@ -13,15 +13,15 @@ import (
 type Pulse struct {
 	ID          int      `json:"id"`
 	Author      string   `json:"author"`
-	CreatedAt   int      `json:"created_at"`
+	CreatedAt   UnixTime `json:"created_at"`
 	Image       string   `json:"image"`
-	PublishedAt int      `json:"published_at"`
+	PublishedAt UnixTime `json:"published_at"`
 	PulseSource int      `json:"pulse_source"`
 	Summary     string   `json:"summary"`
 	Tags        []Tag    `json:"tags"`
 	Title       string   `json:"title"`
 	UID         string   `json:"uid"`
-	UpdatedAt   int      `json:"updated_at"`
+	UpdatedAt   UnixTime `json:"updated_at"`
 	Videos      []string `json:"videos"`
 	Website     int      `json:"website"`
 }
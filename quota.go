@@ -0,0 +1,294 @@
+package igdb
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrQuotaExceeded occurs when a QuotaManager refuses a request because the
+// API key's quota for the current period has been used up.
+var ErrQuotaExceeded = errors.New("IGDB API quota exceeded for the current period")
+
+// DefaultQuotaSyncEvery is the number of requests a new QuotaManager counts
+// locally between synchronizations with the API status.
+const DefaultQuotaSyncEvery = 100
+
+// quotaMetric is the usage report metric tracked by a QuotaManager. The
+// first usage report is tracked if the Status has none for the metric.
+const quotaMetric = "hits"
+
+// QuotaMode determines how a QuotaManager handles requests made once the
+// quota for the current period has been used up.
+type QuotaMode int
+
+// Available quota modes.
+const (
+	// QuotaRefuse fails requests with ErrQuotaExceeded.
+	QuotaRefuse QuotaMode = iota
+	// QuotaQueue holds requests until the current period ends.
+	QuotaQueue
+)
+
+// QuotaManager tracks the usage of an API key against its quota. Requests are
+// counted locally as they are sent and the count is periodically corrected
+// from the Client's Status. Install the QuotaManager's Middleware on the
+// Client it was created with:
+//
+//	q := igdb.NewQuotaManager(c, igdb.QuotaRefuse)
+//	c.Use(q.Middleware())
+//
+// Requests for the API status are never counted or refused.
+type QuotaManager struct {
+	// Mode determines how requests are handled once the quota is used up.
+	Mode QuotaMode
+	// SyncEvery is the number of requests counted locally between
+	// synchronizations with the API status. Zero disables periodic
+	// synchronization.
+	SyncEvery int
+	// Thresholds are the fractions of the quota (e.g. 0.8 for 80%) at which
+	// OnThreshold is called. Each threshold is reported at most once per
+	// period.
+	Thresholds []float64
+	// OnThreshold, if set, is called when usage crosses one of the Thresholds.
+	OnThreshold func(threshold float64, used, limit int)
+
+	client    *Client
+	mu        sync.Mutex
+	synced    bool
+	limit     int
+	used      int
+	start     time.Time
+	end       time.Time
+	crossed   map[float64]bool
+	sinceSync int
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewQuotaManager returns a QuotaManager for the provided Client that handles
+// requests made once the quota is used up according to the provided mode.
+func NewQuotaManager(c *Client, mode QuotaMode) *QuotaManager {
+	return &QuotaManager{
+		Mode:      mode,
+		SyncEvery: DefaultQuotaSyncEvery,
+		client:    c,
+		crossed:   make(map[float64]bool),
+		now:       time.Now,
+		sleep:     time.Sleep,
+	}
+}
+
+// Sync replaces the local request count and period with those reported by
+// the Client's Status.
+func (q *QuotaManager) Sync() error {
+	stat, err := q.client.Status()
+	if err != nil {
+		return errors.Wrap(err, "cannot sync quota")
+	}
+
+	r := stat.Report(quotaMetric)
+	if r == nil {
+		if len(stat.UsageReports) == 0 {
+			return errors.Wrap(ErrNoResults, "cannot sync quota: no usage reports")
+		}
+		r = &stat.UsageReports[0]
+	}
+	start, end := r.PeriodStart, r.PeriodEnd
+
+	q.mu.Lock()
+	if !end.Equal(q.end) {
+		q.crossed = make(map[float64]bool)
+	}
+	q.synced = true
+	q.limit, q.used = r.MaxValue, r.CurrentValue
+	q.start, q.end = start, end
+	q.sinceSync = 0
+	hits := q.cross()
+	q.mu.Unlock()
+
+	q.notify(hits)
+
+	return nil
+}
+
+// Used returns the number of requests made in the current period.
+func (q *QuotaManager) Used() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.used
+}
+
+// Limit returns the number of requests allowed in the current period. It is
+// zero until the QuotaManager has synchronized with the API status.
+func (q *QuotaManager) Limit() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.limit
+}
+
+// Remaining returns the number of requests left in the current period.
+func (q *QuotaManager) Remaining() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.used >= q.limit {
+		return 0
+	}
+
+	return q.limit - q.used
+}
+
+// Period returns the start and end of the current period.
+func (q *QuotaManager) Period() (start, end time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.start, q.end
+}
+
+// Middleware returns a Middleware that counts each request sent by a Call
+// against the quota and refuses or holds Calls once the quota is used up.
+// The first Call synchronizes with the API status if Sync has not been
+// called.
+func (q *QuotaManager) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) error {
+			if call.Endpoint == EndpointStatus {
+				return next(call)
+			}
+
+			if err := q.reserve(); err != nil {
+				return err
+			}
+
+			before := call.Attempts
+			err := next(call)
+			sent := call.Attempts - before
+			if call.StatusCode == 0 {
+				// No response was received, so the request never reached
+				// the API.
+				sent = 0
+			}
+			q.record(sent)
+
+			return err
+		}
+	}
+}
+
+// reserve returns once a request may be sent, holding it until the current
+// period ends if the QuotaManager is in QuotaQueue mode. The request is
+// counted as soon as it is allowed, so concurrent Calls cannot exceed the
+// quota; record corrects the count once the request has been sent.
+func (q *QuotaManager) reserve() error {
+	q.mu.Lock()
+	synced := q.synced
+	q.mu.Unlock()
+
+	if !synced {
+		if err := q.Sync(); err != nil {
+			return err
+		}
+	}
+
+	for {
+		q.mu.Lock()
+		if q.limit <= 0 || q.used < q.limit {
+			q.used++
+			hits := q.cross()
+			q.mu.Unlock()
+
+			q.notify(hits)
+			return nil
+		}
+		end := q.end
+		q.mu.Unlock()
+
+		if q.Mode != QuotaQueue || end.IsZero() {
+			return ErrQuotaExceeded
+		}
+
+		if d := end.Sub(q.now()); d > 0 {
+			q.sleep(d)
+		}
+
+		if err := q.Sync(); err != nil {
+			return err
+		}
+
+		// The API status may lag behind the end of the period; start the
+		// next period locally, assuming it lasts as long as the last one,
+		// rather than waiting again.
+		q.mu.Lock()
+		if !q.end.After(q.now()) {
+			q.used = 0
+			if !q.start.IsZero() && q.end.After(q.start) {
+				q.start, q.end = q.end, q.end.Add(q.end.Sub(q.start))
+			}
+			q.crossed = make(map[float64]bool)
+		}
+		q.mu.Unlock()
+	}
+}
+
+// record counts the provided number of sent requests against the request
+// counted by reserve, giving it back if none was sent. The API status is
+// synchronized every SyncEvery sent requests.
+func (q *QuotaManager) record(n int) {
+	q.mu.Lock()
+	q.used += n - 1
+	if q.used < 0 {
+		q.used = 0
+	}
+	q.sinceSync += n
+	resync := n > 0 && q.SyncEvery > 0 && q.sinceSync >= q.SyncEvery
+	hits := q.cross()
+	q.mu.Unlock()
+
+	q.notify(hits)
+
+	if resync {
+		// A failed sync leaves the local count in place.
+		q.Sync()
+	}
+}
+
+// cross marks and returns the thresholds newly crossed by the current usage
+// in ascending order. The QuotaManager's lock must be held.
+func (q *QuotaManager) cross() []float64 {
+	if q.limit <= 0 {
+		return nil
+	}
+
+	var hits []float64
+	for _, t := range q.Thresholds {
+		if !q.crossed[t] && float64(q.used) >= t*float64(q.limit) {
+			q.crossed[t] = true
+			hits = append(hits, t)
+		}
+	}
+	sort.Float64s(hits)
+
+	return hits
+}
+
+// notify calls OnThreshold for each of the provided thresholds.
+func (q *QuotaManager) notify(hits []float64) {
+	if q.OnThreshold == nil || len(hits) == 0 {
+		return
+	}
+
+	q.mu.Lock()
+	used, limit := q.used, q.limit
+	q.mu.Unlock()
+
+	for _, t := range hits {
+		q.OnThreshold(t, used, limit)
+	}
+}
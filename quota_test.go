@@ -0,0 +1,212 @@
+package igdb
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// testQuotaServer starts a test server whose API status reports the provided
+// quota and period end along with the number of other requests it has
+// received. It returns a Client configured for the server and a pointer to
+// the number of status requests.
+func testQuotaServer(max int, end string) (*httptest.Server, *Client, *int32) {
+	var used, stats int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, string(EndpointStatus)) {
+			atomic.AddInt32(&stats, 1)
+			fmt.Fprintf(w, `[{"authorized": true, "plan": "Free", "usage_reports": {"usage_report": {"metric": "hits", "period": "month", `+
+				`"period_start": "2018-12-01 00:00:00 +0000", "period_end": %q, "max_value": %d, "current_value": %d}}}]`,
+				end, max, atomic.LoadInt32(&used))
+			return
+		}
+		atomic.AddInt32(&used, 1)
+		fmt.Fprint(w, testResult)
+	}))
+
+	c := NewClient(testKey, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c, &stats
+}
+
+func TestQuotaManager_Refuse(t *testing.T) {
+	ts, c, stats := testQuotaServer(3, "2019-01-01 00:00:00 +0000")
+	defer ts.Close()
+
+	q := NewQuotaManager(c, QuotaRefuse)
+	c.Use(q.Middleware())
+
+	var res testResultPlaceholder
+	for i := 0; i < 3; i++ {
+		if err := c.get(testEndpoint, &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := c.get(testEndpoint, &res)
+	if errors.Cause(err) != ErrQuotaExceeded {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrQuotaExceeded)
+	}
+
+	if q.Used() != 3 || q.Limit() != 3 || q.Remaining() != 0 {
+		t.Errorf("got: <%v/%v>, want: <%v/%v>", q.Used(), q.Limit(), 3, 3)
+	}
+	if *stats != 1 {
+		t.Errorf("got: <%v> status requests, want: <%v>", *stats, 1)
+	}
+
+	start, end := q.Period()
+	wantStart := time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC)
+	wantEnd := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !start.Equal(wantStart) || !end.Equal(wantEnd) {
+		t.Errorf("got: <%v - %v>, want: <%v - %v>", start, end, wantStart, wantEnd)
+	}
+}
+
+func TestQuotaManager_Queue(t *testing.T) {
+	ts, c, stats := testQuotaServer(1, "2019-01-01 00:00:00 +0000")
+	defer ts.Close()
+
+	now := time.Date(2018, 12, 31, 23, 0, 0, 0, time.UTC)
+	var slept time.Duration
+
+	q := NewQuotaManager(c, QuotaQueue)
+	q.now = func() time.Time { return now }
+	q.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+	c.Use(q.Middleware())
+
+	// The API status keeps reporting the first period, so the second and
+	// third requests wait for the first period and the local second period.
+	var res testResultPlaceholder
+	for i := 0; i < 3; i++ {
+		if err := c.get(testEndpoint, &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if want := time.Hour + 31*24*time.Hour; slept != want {
+		t.Errorf("got: <%v>, want: <%v>", slept, want)
+	}
+	if q.Used() != 1 {
+		t.Errorf("got: <%v>, want: <%v>", q.Used(), 1)
+	}
+	if *stats != 3 {
+		t.Errorf("got: <%v> status requests, want: <%v>", *stats, 3)
+	}
+
+	_, end := q.Period()
+	if want := time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC); !end.Equal(want) {
+		t.Errorf("got: <%v>, want: <%v>", end, want)
+	}
+}
+
+func TestQuotaManager_Concurrent(t *testing.T) {
+	ts, c, _ := testQuotaServer(3, "2019-01-01 00:00:00 +0000")
+	defer ts.Close()
+
+	q := NewQuotaManager(c, QuotaRefuse)
+	c.Use(q.Middleware())
+	if err := q.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	var ok, refused int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var res testResultPlaceholder
+			err := c.get(testEndpoint, &res)
+			switch {
+			case err == nil:
+				atomic.AddInt32(&ok, 1)
+			case errors.Cause(err) == ErrQuotaExceeded:
+				atomic.AddInt32(&refused, 1)
+			default:
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if ok != 3 || refused != 7 {
+		t.Errorf("got: <%v/%v>, want: <%v/%v>", ok, refused, 3, 7)
+	}
+	if q.Used() != 3 {
+		t.Errorf("got: <%v>, want: <%v>", q.Used(), 3)
+	}
+}
+
+func TestQuotaManager_Unsent(t *testing.T) {
+	ts, c, _ := testQuotaServer(3, "2019-01-01 00:00:00 +0000")
+	defer ts.Close()
+
+	q := NewQuotaManager(c, QuotaRefuse)
+	c.Use(q.Middleware())
+
+	// Calls answered without reaching the API give their request back.
+	c.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			return errors.New("not sent")
+		}
+	})
+
+	var res testResultPlaceholder
+	for i := 0; i < 5; i++ {
+		if err := c.get(testEndpoint, &res); err == nil {
+			t.Fatal("got: <nil>, want an error")
+		}
+	}
+
+	if q.Used() != 0 {
+		t.Errorf("got: <%v>, want: <%v>", q.Used(), 0)
+	}
+}
+
+func TestQuotaManager_Thresholds(t *testing.T) {
+	ts, c, stats := testQuotaServer(10, "2019-01-01 00:00:00 +0000")
+	defer ts.Close()
+
+	type hit struct {
+		threshold float64
+		used      int
+	}
+	var got []hit
+
+	q := NewQuotaManager(c, QuotaRefuse)
+	q.SyncEvery = 4
+	q.Thresholds = []float64{0.9, 0.5}
+	q.OnThreshold = func(threshold float64, used, limit int) {
+		got = append(got, hit{threshold, used})
+	}
+	c.Use(q.Middleware())
+
+	var res testResultPlaceholder
+	for i := 0; i < 9; i++ {
+		if err := c.get(testEndpoint, &res); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []hit{{0.5, 5}, {0.9, 9}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+	if *stats != 3 {
+		t.Errorf("got: <%v> status requests, want: <%v>", *stats, 3)
+	}
+}
@@ -0,0 +1,717 @@
+package igdb
+
+// The Reader interfaces describe the calls available for each IGDB endpoint.
+// Every service in a Client implements its endpoint's Reader interface and
+// each Client field holds that interface rather than the concrete service, so
+// a fake, mock, or decorator (e.g. a cache) can be substituted for any
+// endpoint. The igdbmock package contains generated mocks of every Reader.
+//
+// The Readers hold only the primitive calls of an endpoint. Calls built on
+// top of them, such as GameBySlug or LoadGameDetail, are functions that take
+// the Readers or Client they need instead.
+
+//go:generate go run ./internal/genmock -in $GOFILE -out igdbmock/mock.go
+
+// AchievementReader retrieves Achievements from the IGDB Achievement endpoint.
+// It is implemented by AchievementService.
+type AchievementReader interface {
+	Get(id int, opts ...Option) (*Achievement, error)
+	List(ids []int, opts ...Option) ([]*Achievement, error)
+	Index(opts ...Option) ([]*Achievement, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// AchievementIconReader retrieves AchievementIcons from the IGDB AchievementIcon endpoint.
+// It is implemented by AchievementIconService.
+type AchievementIconReader interface {
+	Get(id int, opts ...Option) (*AchievementIcon, error)
+	List(ids []int, opts ...Option) ([]*AchievementIcon, error)
+	Index(opts ...Option) ([]*AchievementIcon, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// AgeRatingReader retrieves AgeRatings from the IGDB AgeRating endpoint.
+// It is implemented by AgeRatingService.
+type AgeRatingReader interface {
+	Get(id int, opts ...Option) (*AgeRating, error)
+	List(ids []int, opts ...Option) ([]*AgeRating, error)
+	Index(opts ...Option) ([]*AgeRating, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// AgeRatingContentReader retrieves AgeRatingContents from the IGDB AgeRatingContent endpoint.
+// It is implemented by AgeRatingContentService.
+type AgeRatingContentReader interface {
+	Get(id int, opts ...Option) (*AgeRatingContent, error)
+	List(ids []int, opts ...Option) ([]*AgeRatingContent, error)
+	Index(opts ...Option) ([]*AgeRatingContent, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// AlternativeNameReader retrieves AlternativeNames from the IGDB AlternativeName endpoint.
+// It is implemented by AlternativeNameService.
+type AlternativeNameReader interface {
+	Get(id int, opts ...Option) (*AlternativeName, error)
+	List(ids []int, opts ...Option) ([]*AlternativeName, error)
+	Index(opts ...Option) ([]*AlternativeName, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ArtworkReader retrieves Artworks from the IGDB Artwork endpoint.
+// It is implemented by ArtworkService.
+type ArtworkReader interface {
+	Get(id int, opts ...Option) (*Artwork, error)
+	List(ids []int, opts ...Option) ([]*Artwork, error)
+	Index(opts ...Option) ([]*Artwork, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CharacterReader retrieves Characters from the IGDB Character endpoint.
+// It is implemented by CharacterService.
+type CharacterReader interface {
+	Get(id int, opts ...Option) (*Character, error)
+	List(ids []int, opts ...Option) ([]*Character, error)
+	Index(opts ...Option) ([]*Character, error)
+	Search(qry string, opts ...Option) ([]*Character, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CharacterMugshotReader retrieves CharacterMugshots from the IGDB CharacterMugshot endpoint.
+// It is implemented by CharacterMugshotService.
+type CharacterMugshotReader interface {
+	Get(id int, opts ...Option) (*CharacterMugshot, error)
+	List(ids []int, opts ...Option) ([]*CharacterMugshot, error)
+	Index(opts ...Option) ([]*CharacterMugshot, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CollectionReader retrieves Collections from the IGDB Collection endpoint.
+// It is implemented by CollectionService.
+type CollectionReader interface {
+	Get(id int, opts ...Option) (*Collection, error)
+	List(ids []int, opts ...Option) ([]*Collection, error)
+	Index(opts ...Option) ([]*Collection, error)
+	Search(qry string, opts ...Option) ([]*Collection, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CompanyReader retrieves Companies from the IGDB Company endpoint.
+// It is implemented by CompanyService.
+type CompanyReader interface {
+	Get(id int, opts ...Option) (*Company, error)
+	List(ids []int, opts ...Option) ([]*Company, error)
+	Index(opts ...Option) ([]*Company, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CompanyLogoReader retrieves CompanyLogos from the IGDB CompanyLogo endpoint.
+// It is implemented by CompanyLogoService.
+type CompanyLogoReader interface {
+	Get(id int, opts ...Option) (*CompanyLogo, error)
+	List(ids []int, opts ...Option) ([]*CompanyLogo, error)
+	Index(opts ...Option) ([]*CompanyLogo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CompanyWebsiteReader retrieves CompanyWebsites from the IGDB CompanyWebsite endpoint.
+// It is implemented by CompanyWebsiteService.
+type CompanyWebsiteReader interface {
+	Get(id int, opts ...Option) (*CompanyWebsite, error)
+	List(ids []int, opts ...Option) ([]*CompanyWebsite, error)
+	Index(opts ...Option) ([]*CompanyWebsite, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CoverReader retrieves Covers from the IGDB Cover endpoint.
+// It is implemented by CoverService.
+type CoverReader interface {
+	Get(id int, opts ...Option) (*Cover, error)
+	List(ids []int, opts ...Option) ([]*Cover, error)
+	Index(opts ...Option) ([]*Cover, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ExternalGameReader retrieves ExternalGames from the IGDB ExternalGame endpoint.
+// It is implemented by ExternalGameService.
+type ExternalGameReader interface {
+	Get(id int, opts ...Option) (*ExternalGame, error)
+	List(ids []int, opts ...Option) ([]*ExternalGame, error)
+	Index(opts ...Option) ([]*ExternalGame, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// FeedReader retrieves Feeds from the IGDB Feed endpoint.
+// It is implemented by FeedService.
+type FeedReader interface {
+	Get(id int, opts ...Option) (*Feed, error)
+	List(ids []int, opts ...Option) ([]*Feed, error)
+	Index(opts ...Option) ([]*Feed, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// FranchiseReader retrieves Franchises from the IGDB Franchise endpoint.
+// It is implemented by FranchiseService.
+type FranchiseReader interface {
+	Get(id int, opts ...Option) (*Franchise, error)
+	List(ids []int, opts ...Option) ([]*Franchise, error)
+	Index(opts ...Option) ([]*Franchise, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameReader retrieves Games from the IGDB Game endpoint.
+// It is implemented by GameService.
+type GameReader interface {
+	Get(id int, opts ...Option) (*Game, error)
+	List(ids []int, opts ...Option) ([]*Game, error)
+	Index(opts ...Option) ([]*Game, error)
+	Search(qry string, opts ...Option) ([]*Game, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameEngineReader retrieves GameEngines from the IGDB GameEngine endpoint.
+// It is implemented by GameEngineService.
+type GameEngineReader interface {
+	Get(id int, opts ...Option) (*GameEngine, error)
+	List(ids []int, opts ...Option) ([]*GameEngine, error)
+	Index(opts ...Option) ([]*GameEngine, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameEngineLogoReader retrieves GameEngineLogos from the IGDB GameEngineLogo endpoint.
+// It is implemented by GameEngineLogoService.
+type GameEngineLogoReader interface {
+	Get(id int, opts ...Option) (*GameEngineLogo, error)
+	List(ids []int, opts ...Option) ([]*GameEngineLogo, error)
+	Index(opts ...Option) ([]*GameEngineLogo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameModeReader retrieves GameModes from the IGDB GameMode endpoint.
+// It is implemented by GameModeService.
+type GameModeReader interface {
+	Get(id int, opts ...Option) (*GameMode, error)
+	List(ids []int, opts ...Option) ([]*GameMode, error)
+	Index(opts ...Option) ([]*GameMode, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameVersionReader retrieves GameVersions from the IGDB GameVersion endpoint.
+// It is implemented by GameVersionService.
+type GameVersionReader interface {
+	Get(id int, opts ...Option) (*GameVersion, error)
+	List(ids []int, opts ...Option) ([]*GameVersion, error)
+	Index(opts ...Option) ([]*GameVersion, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameVersionFeatureReader retrieves GameVersionFeatures from the IGDB GameVersionFeature endpoint.
+// It is implemented by GameVersionFeatureService.
+type GameVersionFeatureReader interface {
+	Get(id int, opts ...Option) (*GameVersionFeature, error)
+	List(ids []int, opts ...Option) ([]*GameVersionFeature, error)
+	Index(opts ...Option) ([]*GameVersionFeature, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameVersionFeatureValueReader retrieves GameVersionFeatureValues from the IGDB GameVersionFeatureValue endpoint.
+// It is implemented by GameVersionFeatureValueService.
+type GameVersionFeatureValueReader interface {
+	Get(id int, opts ...Option) (*GameVersionFeatureValue, error)
+	List(ids []int, opts ...Option) ([]*GameVersionFeatureValue, error)
+	Index(opts ...Option) ([]*GameVersionFeatureValue, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GameVideoReader retrieves GameVideos from the IGDB GameVideo endpoint.
+// It is implemented by GameVideoService.
+type GameVideoReader interface {
+	Get(id int, opts ...Option) (*GameVideo, error)
+	List(ids []int, opts ...Option) ([]*GameVideo, error)
+	Index(opts ...Option) ([]*GameVideo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// GenreReader retrieves Genres from the IGDB Genre endpoint.
+// It is implemented by GenreService.
+type GenreReader interface {
+	Get(id int, opts ...Option) (*Genre, error)
+	List(ids []int, opts ...Option) ([]*Genre, error)
+	Index(opts ...Option) ([]*Genre, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// InvolvedCompanyReader retrieves InvolvedCompanies from the IGDB InvolvedCompany endpoint.
+// It is implemented by InvolvedCompanyService.
+type InvolvedCompanyReader interface {
+	Get(id int, opts ...Option) (*InvolvedCompany, error)
+	List(ids []int, opts ...Option) ([]*InvolvedCompany, error)
+	Index(opts ...Option) ([]*InvolvedCompany, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// KeywordReader retrieves Keywords from the IGDB Keyword endpoint.
+// It is implemented by KeywordService.
+type KeywordReader interface {
+	Get(id int, opts ...Option) (*Keyword, error)
+	List(ids []int, opts ...Option) ([]*Keyword, error)
+	Index(opts ...Option) ([]*Keyword, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// MultiplayerModeReader retrieves MultiplayerModes from the IGDB MultiplayerMode endpoint.
+// It is implemented by MultiplayerModeService.
+type MultiplayerModeReader interface {
+	Get(id int, opts ...Option) (*MultiplayerMode, error)
+	List(ids []int, opts ...Option) ([]*MultiplayerMode, error)
+	Index(opts ...Option) ([]*MultiplayerMode, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PageReader retrieves Pages from the IGDB Page endpoint.
+// It is implemented by PageService.
+type PageReader interface {
+	Get(id int, opts ...Option) (*Page, error)
+	List(ids []int, opts ...Option) ([]*Page, error)
+	Index(opts ...Option) ([]*Page, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PageBackgroundReader retrieves PageBackgrounds from the IGDB PageBackground endpoint.
+// It is implemented by PageBackgroundService.
+type PageBackgroundReader interface {
+	Get(id int, opts ...Option) (*PageBackground, error)
+	List(ids []int, opts ...Option) ([]*PageBackground, error)
+	Index(opts ...Option) ([]*PageBackground, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PageLogoReader retrieves PageLogos from the IGDB PageLogo endpoint.
+// It is implemented by PageLogoService.
+type PageLogoReader interface {
+	Get(id int, opts ...Option) (*PageLogo, error)
+	List(ids []int, opts ...Option) ([]*PageLogo, error)
+	Index(opts ...Option) ([]*PageLogo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PageWebsiteReader retrieves PageWebsites from the IGDB PageWebsite endpoint.
+// It is implemented by PageWebsiteService.
+type PageWebsiteReader interface {
+	Get(id int, opts ...Option) (*PageWebsite, error)
+	List(ids []int, opts ...Option) ([]*PageWebsite, error)
+	Index(opts ...Option) ([]*PageWebsite, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformReader retrieves Platforms from the IGDB Platform endpoint.
+// It is implemented by PlatformService.
+type PlatformReader interface {
+	Get(id int, opts ...Option) (*Platform, error)
+	List(ids []int, opts ...Option) ([]*Platform, error)
+	Index(opts ...Option) ([]*Platform, error)
+	Search(qry string, opts ...Option) ([]*Platform, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformLogoReader retrieves PlatformLogos from the IGDB PlatformLogo endpoint.
+// It is implemented by PlatformLogoService.
+type PlatformLogoReader interface {
+	Get(id int, opts ...Option) (*PlatformLogo, error)
+	List(ids []int, opts ...Option) ([]*PlatformLogo, error)
+	Index(opts ...Option) ([]*PlatformLogo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformVersionReader retrieves PlatformVersions from the IGDB PlatformVersion endpoint.
+// It is implemented by PlatformVersionService.
+type PlatformVersionReader interface {
+	Get(id int, opts ...Option) (*PlatformVersion, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersion, error)
+	Index(opts ...Option) ([]*PlatformVersion, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformVersionCompanyReader retrieves PlatformVersionCompanies from the IGDB PlatformVersionCompany endpoint.
+// It is implemented by PlatformVersionCompanyService.
+type PlatformVersionCompanyReader interface {
+	Get(id int, opts ...Option) (*PlatformVersionCompany, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersionCompany, error)
+	Index(opts ...Option) ([]*PlatformVersionCompany, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformVersionReleaseDateReader retrieves PlatformVersionReleaseDates from the IGDB PlatformVersionReleaseDate endpoint.
+// It is implemented by PlatformVersionReleaseDateService.
+type PlatformVersionReleaseDateReader interface {
+	Get(id int, opts ...Option) (*PlatformVersionReleaseDate, error)
+	List(ids []int, opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	Index(opts ...Option) ([]*PlatformVersionReleaseDate, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlatformWebsiteReader retrieves PlatformWebsites from the IGDB PlatformWebsite endpoint.
+// It is implemented by PlatformWebsiteService.
+type PlatformWebsiteReader interface {
+	Get(id int, opts ...Option) (*PlatformWebsite, error)
+	List(ids []int, opts ...Option) ([]*PlatformWebsite, error)
+	Index(opts ...Option) ([]*PlatformWebsite, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PlayerPerspectiveReader retrieves PlayerPerspectives from the IGDB PlayerPerspective endpoint.
+// It is implemented by PlayerPerspectiveService.
+type PlayerPerspectiveReader interface {
+	Get(id int, opts ...Option) (*PlayerPerspective, error)
+	List(ids []int, opts ...Option) ([]*PlayerPerspective, error)
+	Index(opts ...Option) ([]*PlayerPerspective, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ProductFamilyReader retrieves ProductFamilies from the IGDB ProductFamily endpoint.
+// It is implemented by ProductFamilyService.
+type ProductFamilyReader interface {
+	Get(id int, opts ...Option) (*ProductFamily, error)
+	List(ids []int, opts ...Option) ([]*ProductFamily, error)
+	Index(opts ...Option) ([]*ProductFamily, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PulseReader retrieves Pulses from the IGDB Pulse endpoint.
+// It is implemented by PulseService.
+type PulseReader interface {
+	Get(id int, opts ...Option) (*Pulse, error)
+	List(ids []int, opts ...Option) ([]*Pulse, error)
+	Index(opts ...Option) ([]*Pulse, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PulseGroupReader retrieves PulseGroups from the IGDB PulseGroup endpoint.
+// It is implemented by PulseGroupService.
+type PulseGroupReader interface {
+	Get(id int, opts ...Option) (*PulseGroup, error)
+	List(ids []int, opts ...Option) ([]*PulseGroup, error)
+	Index(opts ...Option) ([]*PulseGroup, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PulseSourceReader retrieves PulseSources from the IGDB PulseSource endpoint.
+// It is implemented by PulseSourceService.
+type PulseSourceReader interface {
+	Get(id int, opts ...Option) (*PulseSource, error)
+	List(ids []int, opts ...Option) ([]*PulseSource, error)
+	Index(opts ...Option) ([]*PulseSource, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PulseURLReader retrieves PulseURLs from the IGDB PulseURL endpoint.
+// It is implemented by PulseURLService.
+type PulseURLReader interface {
+	Get(id int, opts ...Option) (*PulseURL, error)
+	List(ids []int, opts ...Option) ([]*PulseURL, error)
+	Index(opts ...Option) ([]*PulseURL, error)
+	Search(qry string, opts ...Option) ([]*PulseURL, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ReleaseDateReader retrieves ReleaseDates from the IGDB ReleaseDate endpoint.
+// It is implemented by ReleaseDateService.
+type ReleaseDateReader interface {
+	Get(id int, opts ...Option) (*ReleaseDate, error)
+	List(ids []int, opts ...Option) ([]*ReleaseDate, error)
+	Index(opts ...Option) ([]*ReleaseDate, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ScreenshotReader retrieves Screenshots from the IGDB Screenshot endpoint.
+// It is implemented by ScreenshotService.
+type ScreenshotReader interface {
+	Get(id int, opts ...Option) (*Screenshot, error)
+	List(ids []int, opts ...Option) ([]*Screenshot, error)
+	Index(opts ...Option) ([]*Screenshot, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ThemeReader retrieves Themes from the IGDB Theme endpoint.
+// It is implemented by ThemeService.
+type ThemeReader interface {
+	Get(id int, opts ...Option) (*Theme, error)
+	List(ids []int, opts ...Option) ([]*Theme, error)
+	Index(opts ...Option) ([]*Theme, error)
+	Search(qry string, opts ...Option) ([]*Theme, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// TimeToBeatReader retrieves TimeToBeats from the IGDB TimeToBeat endpoint.
+// It is implemented by TimeToBeatService.
+type TimeToBeatReader interface {
+	Get(id int, opts ...Option) (*TimeToBeat, error)
+	List(ids []int, opts ...Option) ([]*TimeToBeat, error)
+	Index(opts ...Option) ([]*TimeToBeat, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// TitleReader retrieves Titles from the IGDB Title endpoint.
+// It is implemented by TitleService.
+type TitleReader interface {
+	Get(id int, opts ...Option) (*Title, error)
+	List(ids []int, opts ...Option) ([]*Title, error)
+	Index(opts ...Option) ([]*Title, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// WebsiteReader retrieves Websites from the IGDB Website endpoint.
+// It is implemented by WebsiteService.
+type WebsiteReader interface {
+	Get(id int, opts ...Option) (*Website, error)
+	List(ids []int, opts ...Option) ([]*Website, error)
+	Index(opts ...Option) ([]*Website, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// CreditReader retrieves Credits from the IGDB Credit endpoint.
+// It is implemented by CreditService.
+type CreditReader interface {
+	Get(id int, opts ...Option) (*Credit, error)
+	List(ids []int, opts ...Option) ([]*Credit, error)
+	Index(opts ...Option) ([]*Credit, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// FeedFollowReader retrieves FeedFollows from the IGDB FeedFollow endpoint.
+// It is implemented by FeedFollowService.
+type FeedFollowReader interface {
+	Get(id int, opts ...Option) (*FeedFollow, error)
+	List(ids []int, opts ...Option) ([]*FeedFollow, error)
+	Index(opts ...Option) ([]*FeedFollow, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// FollowReader retrieves Follows from the IGDB Follow endpoint.
+// It is implemented by FollowService.
+type FollowReader interface {
+	Get(id int, opts ...Option) (*Follow, error)
+	List(ids []int, opts ...Option) ([]*Follow, error)
+	Index(opts ...Option) ([]*Follow, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ListReader retrieves Lists from the IGDB List endpoint.
+// It is implemented by ListService.
+type ListReader interface {
+	Get(id int, opts ...Option) (*List, error)
+	List(ids []int, opts ...Option) ([]*List, error)
+	Index(opts ...Option) ([]*List, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ListEntryReader retrieves ListEntrys from the IGDB ListEntry endpoint.
+// It is implemented by ListEntryService.
+type ListEntryReader interface {
+	Get(id int, opts ...Option) (*ListEntry, error)
+	List(ids []int, opts ...Option) ([]*ListEntry, error)
+	Index(opts ...Option) ([]*ListEntry, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PersonReader retrieves Persons from the IGDB Person endpoint.
+// It is implemented by PersonService.
+type PersonReader interface {
+	Get(id int, opts ...Option) (*Person, error)
+	List(ids []int, opts ...Option) ([]*Person, error)
+	Index(opts ...Option) ([]*Person, error)
+	Search(qry string, opts ...Option) ([]*Person, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PersonMugshotReader retrieves PersonMugshots from the IGDB PersonMugshot endpoint.
+// It is implemented by PersonMugshotService.
+type PersonMugshotReader interface {
+	Get(id int, opts ...Option) (*PersonMugshot, error)
+	List(ids []int, opts ...Option) ([]*PersonMugshot, error)
+	Index(opts ...Option) ([]*PersonMugshot, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// PersonWebsiteReader retrieves PersonWebsites from the IGDB PersonWebsite endpoint.
+// It is implemented by PersonWebsiteService.
+type PersonWebsiteReader interface {
+	Get(id int, opts ...Option) (*PersonWebsite, error)
+	List(ids []int, opts ...Option) ([]*PersonWebsite, error)
+	Index(opts ...Option) ([]*PersonWebsite, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// RateReader retrieves Rates from the IGDB Rate endpoint.
+// It is implemented by RateService.
+type RateReader interface {
+	Get(id int, opts ...Option) (*Rate, error)
+	List(ids []int, opts ...Option) ([]*Rate, error)
+	Index(opts ...Option) ([]*Rate, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ReviewReader retrieves Reviews from the IGDB Review endpoint.
+// It is implemented by ReviewService.
+type ReviewReader interface {
+	Get(id int, opts ...Option) (*Review, error)
+	List(ids []int, opts ...Option) ([]*Review, error)
+	Index(opts ...Option) ([]*Review, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// ReviewVideoReader retrieves ReviewVideos from the IGDB ReviewVideo endpoint.
+// It is implemented by ReviewVideoService.
+type ReviewVideoReader interface {
+	Get(id int, opts ...Option) (*ReviewVideo, error)
+	List(ids []int, opts ...Option) ([]*ReviewVideo, error)
+	Index(opts ...Option) ([]*ReviewVideo, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// SocialMetricReader retrieves SocialMetrics from the IGDB SocialMetric endpoint.
+// It is implemented by SocialMetricService.
+type SocialMetricReader interface {
+	Get(id int, opts ...Option) (*SocialMetric, error)
+	List(ids []int, opts ...Option) ([]*SocialMetric, error)
+	Index(opts ...Option) ([]*SocialMetric, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// TestDummyReader retrieves TestDummies from the IGDB TestDummy endpoint.
+// It is implemented by TestDummyService.
+type TestDummyReader interface {
+	Get(id int, opts ...Option) (*TestDummy, error)
+	List(ids []int, opts ...Option) ([]*TestDummy, error)
+	Index(opts ...Option) ([]*TestDummy, error)
+	Count(opts ...Option) (int, error)
+	Fields() ([]string, error)
+}
+
+// Compile-time checks that each service implements its Reader interface.
+var (
+	_ AchievementReader                = (*AchievementService)(nil)
+	_ AchievementIconReader            = (*AchievementIconService)(nil)
+	_ AgeRatingReader                  = (*AgeRatingService)(nil)
+	_ AgeRatingContentReader           = (*AgeRatingContentService)(nil)
+	_ AlternativeNameReader            = (*AlternativeNameService)(nil)
+	_ ArtworkReader                    = (*ArtworkService)(nil)
+	_ CharacterReader                  = (*CharacterService)(nil)
+	_ CharacterMugshotReader           = (*CharacterMugshotService)(nil)
+	_ CollectionReader                 = (*CollectionService)(nil)
+	_ CompanyReader                    = (*CompanyService)(nil)
+	_ CompanyLogoReader                = (*CompanyLogoService)(nil)
+	_ CompanyWebsiteReader             = (*CompanyWebsiteService)(nil)
+	_ CoverReader                      = (*CoverService)(nil)
+	_ ExternalGameReader               = (*ExternalGameService)(nil)
+	_ FeedReader                       = (*FeedService)(nil)
+	_ FranchiseReader                  = (*FranchiseService)(nil)
+	_ GameReader                       = (*GameService)(nil)
+	_ GameEngineReader                 = (*GameEngineService)(nil)
+	_ GameEngineLogoReader             = (*GameEngineLogoService)(nil)
+	_ GameModeReader                   = (*GameModeService)(nil)
+	_ GameVersionReader                = (*GameVersionService)(nil)
+	_ GameVersionFeatureReader         = (*GameVersionFeatureService)(nil)
+	_ GameVersionFeatureValueReader    = (*GameVersionFeatureValueService)(nil)
+	_ GameVideoReader                  = (*GameVideoService)(nil)
+	_ GenreReader                      = (*GenreService)(nil)
+	_ InvolvedCompanyReader            = (*InvolvedCompanyService)(nil)
+	_ KeywordReader                    = (*KeywordService)(nil)
+	_ MultiplayerModeReader            = (*MultiplayerModeService)(nil)
+	_ PageReader                       = (*PageService)(nil)
+	_ PageBackgroundReader             = (*PageBackgroundService)(nil)
+	_ PageLogoReader                   = (*PageLogoService)(nil)
+	_ PageWebsiteReader                = (*PageWebsiteService)(nil)
+	_ PlatformReader                   = (*PlatformService)(nil)
+	_ PlatformLogoReader               = (*PlatformLogoService)(nil)
+	_ PlatformVersionReader            = (*PlatformVersionService)(nil)
+	_ PlatformVersionCompanyReader     = (*PlatformVersionCompanyService)(nil)
+	_ PlatformVersionReleaseDateReader = (*PlatformVersionReleaseDateService)(nil)
+	_ PlatformWebsiteReader            = (*PlatformWebsiteService)(nil)
+	_ PlayerPerspectiveReader          = (*PlayerPerspectiveService)(nil)
+	_ ProductFamilyReader              = (*ProductFamilyService)(nil)
+	_ PulseReader                      = (*PulseService)(nil)
+	_ PulseGroupReader                 = (*PulseGroupService)(nil)
+	_ PulseSourceReader                = (*PulseSourceService)(nil)
+	_ PulseURLReader                   = (*PulseURLService)(nil)
+	_ ReleaseDateReader                = (*ReleaseDateService)(nil)
+	_ ScreenshotReader                 = (*ScreenshotService)(nil)
+	_ ThemeReader                      = (*ThemeService)(nil)
+	_ TimeToBeatReader                 = (*TimeToBeatService)(nil)
+	_ TitleReader                      = (*TitleService)(nil)
+	_ WebsiteReader                    = (*WebsiteService)(nil)
+	_ CreditReader                     = (*CreditService)(nil)
+	_ FeedFollowReader                 = (*FeedFollowService)(nil)
+	_ FollowReader                     = (*FollowService)(nil)
+	_ ListReader                       = (*ListService)(nil)
+	_ ListEntryReader                  = (*ListEntryService)(nil)
+	_ PersonReader                     = (*PersonService)(nil)
+	_ PersonMugshotReader              = (*PersonMugshotService)(nil)
+	_ PersonWebsiteReader              = (*PersonWebsiteService)(nil)
+	_ RateReader                       = (*RateService)(nil)
+	_ ReviewReader                     = (*ReviewService)(nil)
+	_ ReviewVideoReader                = (*ReviewVideoService)(nil)
+	_ SocialMetricReader               = (*SocialMetricService)(nil)
+	_ TestDummyReader                  = (*TestDummyService)(nil)
+)
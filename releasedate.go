@@ -14,14 +14,14 @@ import (
 type ReleaseDate struct {
 	ID        int            `json:"id"`
 	Category  DateCategory   `json:"category"`
-	CreatedAt int            `json:"created_at"`
-	Date      int            `json:"date"`
+	CreatedAt UnixTime       `json:"created_at"`
+	Date      UnixTime       `json:"date"`
 	Game      int            `json:"game"`
 	Human     string         `json:"human"`
 	M         int            `json:"m"`
 	Platform  int            `json:"platform"`
 	Region    RegionCategory `json:"region"`
-	UpdatedAt int            `json:"updated_at"`
+	UpdatedAt UnixTime       `json:"updated_at"`
 	Y         int            `json:"y"`
 }
 
@@ -55,6 +55,8 @@ const (
 	RegionChina
 	RegionAsia
 	RegionWorldwide
+	RegionKorea
+	RegionBrazil
 )
 
 // ReleaseDateService handles all the API calls for the IGDB ReleaseDate endpoint.
@@ -0,0 +1,159 @@
+package igdb
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ReleaseTimeline contains the earliest release of a Game on each of its
+// platforms in each region.
+type ReleaseTimeline struct {
+	// Game is the ID of the Game the timeline describes.
+	Game int
+	// Releases holds the earliest ReleaseDate for every platform and region
+	// pair, ordered by date. A TBD ReleaseDate is only present for a pair
+	// that has no other ReleaseDate.
+	Releases []*ReleaseDate
+	// HasTBD is true if any of the Game's ReleaseDates is TBD.
+	HasTBD bool
+}
+
+// releaseKey identifies a platform and region pair in a ReleaseTimeline.
+type releaseKey struct {
+	platform int
+	region   RegionCategory
+}
+
+// newReleaseTimeline returns the ReleaseTimeline for the provided Game
+// built from its ReleaseDates.
+func newReleaseTimeline(game int, dates []*ReleaseDate) *ReleaseTimeline {
+	tl := &ReleaseTimeline{Game: game}
+
+	earliest := make(map[releaseKey]*ReleaseDate)
+	for _, d := range dates {
+		fd := d.FuzzyDate()
+		if fd.IsTBD() {
+			tl.HasTBD = true
+		}
+
+		k := releaseKey{d.Platform, d.Region}
+		if cur, ok := earliest[k]; !ok || fd.Compare(cur.FuzzyDate()) < 0 {
+			earliest[k] = d
+		}
+	}
+
+	for _, d := range earliest {
+		tl.Releases = append(tl.Releases, d)
+	}
+	sort.Slice(tl.Releases, func(i, j int) bool {
+		a, b := tl.Releases[i], tl.Releases[j]
+		if c := a.FuzzyDate().Compare(b.FuzzyDate()); c != 0 {
+			return c < 0
+		}
+		if a.Platform != b.Platform {
+			return a.Platform < b.Platform
+		}
+		return a.Region < b.Region
+	})
+
+	return tl
+}
+
+// Release returns the earliest release on the provided platform in the
+// provided region. If the Game has no release in the region, its worldwide
+// release on the platform is returned instead. Nil is returned if neither
+// exists.
+func (tl *ReleaseTimeline) Release(platform int, region RegionCategory) *ReleaseDate {
+	var ww *ReleaseDate
+	for _, d := range tl.Releases {
+		if d.Platform != platform {
+			continue
+		}
+		if d.Region == region {
+			return d
+		}
+		if d.Region == RegionWorldwide {
+			ww = d
+		}
+	}
+
+	return ww
+}
+
+// Platform returns the earliest release on the provided platform in each
+// region, ordered by date.
+func (tl *ReleaseTimeline) Platform(platform int) []*ReleaseDate {
+	var rel []*ReleaseDate
+	for _, d := range tl.Releases {
+		if d.Platform == platform {
+			rel = append(rel, d)
+		}
+	}
+
+	return rel
+}
+
+// Region returns the earliest release in the provided region on each
+// platform, ordered by date. The worldwide release is used for any platform
+// without a release in the region.
+func (tl *ReleaseTimeline) Region(region RegionCategory) []*ReleaseDate {
+	found := make(map[int]*ReleaseDate)
+	for _, d := range tl.Releases {
+		if d.Region != region && d.Region != RegionWorldwide {
+			continue
+		}
+		if cur, ok := found[d.Platform]; !ok || (cur.Region != region && d.Region == region) {
+			found[d.Platform] = d
+		}
+	}
+
+	var rel []*ReleaseDate
+	for _, d := range tl.Releases {
+		if found[d.Platform] == d {
+			rel = append(rel, d)
+		}
+	}
+
+	return rel
+}
+
+// Earliest returns the Game's earliest release on any platform in any
+// region. Nil is returned if the timeline has no releases.
+func (tl *ReleaseTimeline) Earliest() *ReleaseDate {
+	if len(tl.Releases) == 0 {
+		return nil
+	}
+
+	return tl.Releases[0]
+}
+
+// LoadReleaseTimeline returns the ReleaseTimeline of the Game identified by
+// the provided IGDB ID, built from all of the Game's ReleaseDates as
+// retrieved by the provided ReleaseDateReader. The ReleaseDates are retrieved
+// a page at a time until none are left. If the Game has no ReleaseDates, an
+// error is returned.
+func LoadReleaseTimeline(r ReleaseDateReader, id int) (*ReleaseTimeline, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var dates []*ReleaseDate
+	err := indexAll(func(opts ...Option) (int, int, error) {
+		page, err := r.Index(opts...)
+		if len(page) == 0 {
+			return 0, 0, err
+		}
+		dates = append(dates, page...)
+		return len(page), page[len(page)-1].ID, err
+	}, SetFields("*"), SetFilter("game", OpEquals, strconv.Itoa(id)))
+	if err == nil && len(dates) == 0 {
+		err = ErrNoResults
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get release timeline of Game with ID %v", id)
+	}
+
+	return newReleaseTimeline(id, dates), nil
+}
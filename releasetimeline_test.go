@@ -0,0 +1,145 @@
+package igdb_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+	"github.com/pkg/errors"
+)
+
+// Platform IDs used by the release timeline tests.
+const (
+	testSwitch = 130
+	testPC     = 6
+)
+
+func TestLoadReleaseTimeline(t *testing.T) {
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	err := srv.LoadAll(map[string]string{string(igdb.EndpointReleaseDate): `[
+		{"id": 1, "game": 7, "platform": 130, "region": 8, "category": 0, "date": 1538092800, "y": 2018, "m": 9},
+		{"id": 2, "game": 7, "platform": 130, "region": 5, "category": 0, "date": 1537488000, "y": 2018, "m": 9},
+		{"id": 3, "game": 7, "platform": 130, "region": 5, "category": 0, "date": 1546300800, "y": 2019, "m": 1},
+		{"id": 4, "game": 7, "platform": 6, "region": 8, "category": 2, "date": 1514764800, "y": 2018},
+		{"id": 5, "game": 7, "platform": 6, "region": 1, "category": 7},
+		{"id": 6, "game": 8, "platform": 6, "region": 8, "category": 0, "date": 1000000000}
+	]`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tl, err := igdb.LoadReleaseTimeline(srv.NewClient().ReleaseDates, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for _, d := range tl.Releases {
+		got = append(got, d.ID)
+	}
+	want := []int{4, 2, 1, 5}
+	if !equalInts(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if !tl.HasTBD {
+		t.Errorf("got: <%v>, want: <%v>", tl.HasTBD, true)
+	}
+
+	tests := []struct {
+		name     string
+		platform int
+		region   igdb.RegionCategory
+		wantID   int
+	}{
+		{"Japan on Switch", testSwitch, igdb.RegionJapan, 2},
+		{"Europe on Switch falls back to worldwide", testSwitch, igdb.RegionEurope, 1},
+		{"Europe on PC is TBD", testPC, igdb.RegionEurope, 5},
+		{"Unknown platform", 48, igdb.RegionJapan, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var id int
+			if d := tl.Release(test.platform, test.region); d != nil {
+				id = d.ID
+			}
+			if id != test.wantID {
+				t.Errorf("got: <%v>, want: <%v>", id, test.wantID)
+			}
+		})
+	}
+
+	if e := tl.Earliest(); e == nil || e.ID != 4 {
+		t.Errorf("got: <%v>, want: <%v>", e, 4)
+	}
+
+	var ids []int
+	for _, d := range tl.Region(igdb.RegionJapan) {
+		ids = append(ids, d.ID)
+	}
+	if !equalInts(ids, []int{4, 2}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{4, 2})
+	}
+
+	ids = nil
+	for _, d := range tl.Platform(testPC) {
+		ids = append(ids, d.ID)
+	}
+	if !equalInts(ids, []int{4, 5}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{4, 5})
+	}
+}
+
+func TestLoadReleaseTimelinePages(t *testing.T) {
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	const n = 1200
+	dates := make([]string, n)
+	for i := range dates {
+		dates[i] = fmt.Sprintf(`{"id": %d, "game": 7, "platform": %d, "region": 8, "category": 0, "date": %d}`, i+1, i+1, 1500000000+i)
+	}
+
+	err := srv.LoadAll(map[string]string{string(igdb.EndpointReleaseDate): "[" + strings.Join(dates, ",") + "]"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tl, err := igdb.LoadReleaseTimeline(srv.NewClient().ReleaseDates, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tl.Releases) != n {
+		t.Errorf("got: <%v>, want: <%v>", len(tl.Releases), n)
+	}
+}
+
+func TestLoadReleaseTimelineErrors(t *testing.T) {
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		id      int
+		wantErr error
+	}{
+		{"Negative ID", -1, igdb.ErrNegativeID},
+		{"No release dates", 7, igdb.ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tl, err := igdb.LoadReleaseTimeline(srv.NewClient().ReleaseDates, test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+			if tl != nil {
+				t.Errorf("got: <%v>, want: <%v>", tl, nil)
+			}
+		})
+	}
+}
@@ -15,7 +15,7 @@ type Review struct {
 	Category       ReviewCategory `json:"category"`
 	Conclusion     string         `json:"conclusion"`
 	Content        string         `json:"content"`
-	CreatedAt      int            `json:"created_at"`
+	CreatedAt      UnixTime       `json:"created_at"`
 	Game           int            `json:"game"`
 	Introduction   string         `json:"introduction"`
 	Likes          int            `json:"likes"`
@@ -24,7 +24,7 @@ type Review struct {
 	PositivePoints string         `json:"positive_points"`
 	Slug           string         `json:"slug"`
 	Title          string         `json:"title"`
-	UpdatedAt      int            `json:"updated_at"`
+	UpdatedAt      UnixTime       `json:"updated_at"`
 	URL            string         `json:"url"`
 	User           int            `json:"user"`
 	UserRating     int            `json:"user_rating"`
@@ -65,6 +65,19 @@ func (rs *ReviewService) Get(id int, opts ...Option) (*Review, error) {
 	return rev[0], nil
 }
 
+// ReviewBySlug returns a single Review identified by the provided slug using
+// the provided ReviewReader, such as the Reviews field of a Client. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Reviews, an error is returned.
+func ReviewBySlug(r ReviewReader, slug string, opts ...Option) (*Review, error) {
+	rev, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Review with slug %s", slug)
+	}
+
+	return rev[0], nil
+}
+
 // List returns a list of Reviews identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Review is ignored. If none of the IDs
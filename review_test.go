@@ -57,6 +57,49 @@ func TestReviewService_Get(t *testing.T) {
 	}
 }
 
+func TestReviewBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testReviewGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Review, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name       string
+		file       string
+		slug       string
+		opts       []Option
+		wantReview *Review
+		wantErr    error
+	}{
+		{"Valid response", testReviewGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			rev, err := ReviewBySlug(c.Reviews, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(rev, test.wantReview) {
+				t.Errorf("got: <%v>, \nwant: <%v>", rev, test.wantReview)
+			}
+		})
+	}
+}
+
 func TestReviewService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testReviewList)
 	if err != nil {
@@ -0,0 +1,120 @@
+package igdb
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrIGDBURL occurs when a URL does not address an entity on igdb.com.
+var ErrIGDBURL = errors.New("unrecognized igdb.com url")
+
+// igdbHost is the host of the IGDB website.
+const igdbHost = "igdb.com"
+
+// urlEndpoints holds the endpoint addressed by the first segment of the path
+// of an entity's page on igdb.com.
+var urlEndpoints = map[string]endpoint{
+	"characters":          EndpointCharacter,
+	"collections":         EndpointCollection,
+	"companies":           EndpointCompany,
+	"franchises":          EndpointFranchise,
+	"game_engines":        EndpointGameEngine,
+	"game_modes":          EndpointGameMode,
+	"games":               EndpointGame,
+	"genres":              EndpointGenre,
+	"platforms":           EndpointPlatform,
+	"player_perspectives": EndpointPlayerPerspective,
+	"themes":              EndpointTheme,
+}
+
+// ParseIGDBURL parses the provided URL of an entity's page on igdb.com and
+// returns the endpoint and slug of the entity. The pages of Characters,
+// Collections, Companies, Franchises, GameEngines, GameModes, Games, Genres,
+// Platforms, PlayerPerspectives, and Themes are recognized, as are the pages
+// of user lists, such as https://www.igdb.com/users/name/lists/slug, which
+// address the List endpoint. Sub-pages, such as
+// https://www.igdb.com/games/slug/reviews, address the entity of the page
+// they belong to.
+//
+// URLs of Feeds, Pages, Reviews, and PlatformVersions are not recognized;
+// retrieve those with FeedBySlug, PageBySlug, ReviewBySlug, or
+// PlatformVersionBySlug instead.
+func ParseIGDBURL(rawURL string) (endpoint, string, error) {
+	s := strings.TrimSpace(rawURL)
+	if strings.HasPrefix(s, "//") {
+		s = "https:" + s
+	} else if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", "", errors.Wrap(ErrIGDBURL, err.Error())
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	parts := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+
+	if host == igdbHost {
+		switch {
+		case len(parts) >= 4 && parts[0] == "users" && parts[2] == "lists":
+			return EndpointList, parts[3], nil
+		case len(parts) >= 2:
+			if end, ok := urlEndpoints[parts[0]]; ok {
+				return end, parts[1], nil
+			}
+		}
+	}
+
+	return "", "", errors.Wrapf(ErrIGDBURL, "cannot parse '%s'", rawURL)
+}
+
+// ResolveURL returns the entity whose page on igdb.com is addressed by the
+// provided URL, such as a *Game for
+// https://www.igdb.com/games/the-witcher-3-wild-hunt or a *Company for
+// https://www.igdb.com/companies/cd-projekt-red. The URLs recognized are
+// described by ParseIGDBURL. Use a type switch to retrieve the typed entity.
+// Provide the SetFields functional option if you need to specify which
+// fields to retrieve. If the URL is not recognized or its slug does not
+// match any entity, an error is returned.
+func (c *Client) ResolveURL(rawURL string, opts ...Option) (interface{}, error) {
+	end, slug, err := ParseIGDBURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var ent interface{}
+	switch end {
+	case EndpointCharacter:
+		ent, err = CharacterBySlug(c.Characters, slug, opts...)
+	case EndpointCollection:
+		ent, err = CollectionBySlug(c.Collections, slug, opts...)
+	case EndpointCompany:
+		ent, err = CompanyBySlug(c.Companies, slug, opts...)
+	case EndpointFranchise:
+		ent, err = FranchiseBySlug(c.Franchises, slug, opts...)
+	case EndpointGameEngine:
+		ent, err = GameEngineBySlug(c.GameEngines, slug, opts...)
+	case EndpointGameMode:
+		ent, err = GameModeBySlug(c.GameModes, slug, opts...)
+	case EndpointGame:
+		ent, err = GameBySlug(c.Games, slug, opts...)
+	case EndpointGenre:
+		ent, err = GenreBySlug(c.Genres, slug, opts...)
+	case EndpointList:
+		ent, err = ListBySlug(c.Lists, slug, opts...)
+	case EndpointPlatform:
+		ent, err = PlatformBySlug(c.Platforms, slug, opts...)
+	case EndpointPlayerPerspective:
+		ent, err = PlayerPerspectiveBySlug(c.PlayerPerspectives, slug, opts...)
+	case EndpointTheme:
+		ent, err = ThemeBySlug(c.Themes, slug, opts...)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot resolve '%s'", rawURL)
+	}
+
+	return ent, nil
+}
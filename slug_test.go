@@ -0,0 +1,93 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseIGDBURL(t *testing.T) {
+	var tests = []struct {
+		name     string
+		url      string
+		wantEnd  endpoint
+		wantSlug string
+		wantErr  error
+	}{
+		{"Game", "https://www.igdb.com/games/the-witcher-3-wild-hunt", EndpointGame, "the-witcher-3-wild-hunt", nil},
+		{"Trailing slash", "https://www.igdb.com/games/the-witcher-3-wild-hunt/", EndpointGame, "the-witcher-3-wild-hunt", nil},
+		{"Query", "https://igdb.com/companies/cd-projekt-red?tab=games", EndpointCompany, "cd-projekt-red", nil},
+		{"No scheme", "www.igdb.com/platforms/win", EndpointPlatform, "win", nil},
+		{"Scheme relative", "//www.igdb.com/game_engines/redengine", EndpointGameEngine, "redengine", nil},
+		{"Player perspective", "https://www.igdb.com/player_perspectives/third-person", EndpointPlayerPerspective, "third-person", nil},
+		{"User list", "https://www.igdb.com/users/igdb/lists/best-rpgs", EndpointList, "best-rpgs", nil},
+		{"Unknown kind", "https://www.igdb.com/events/e3", "", "", ErrIGDBURL},
+		{"Missing slug", "https://www.igdb.com/games", "", "", ErrIGDBURL},
+		{"Sub-page", "https://www.igdb.com/games/the-witcher-3-wild-hunt/reviews", EndpointGame, "the-witcher-3-wild-hunt", nil},
+		{"Nested sub-page", "https://www.igdb.com/companies/cd-projekt-red/games/2015", EndpointCompany, "cd-projekt-red", nil},
+		{"User list sub-page", "https://www.igdb.com/users/igdb/lists/best-rpgs/edit", EndpointList, "best-rpgs", nil},
+		{"User without list", "https://www.igdb.com/users/igdb", "", "", ErrIGDBURL},
+		{"Unsupported kind", "https://www.igdb.com/feeds/some-feed", "", "", ErrIGDBURL},
+		{"Other host", "https://example.com/games/the-witcher-3-wild-hunt", "", "", ErrIGDBURL},
+		{"Empty", "", "", "", ErrIGDBURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			end, slug, err := ParseIGDBURL(test.url)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if end != test.wantEnd || slug != test.wantSlug {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", end, slug, test.wantEnd, test.wantSlug)
+			}
+		})
+	}
+}
+
+func TestClient_ResolveURL(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name    string
+		file    string
+		url     string
+		want    interface{}
+		wantErr error
+	}{
+		{"Valid response", testGameGet, "https://www.igdb.com/games/the-witcher-3-wild-hunt", init[0], nil},
+		{"Sub-page", testGameGet, "https://www.igdb.com/games/the-witcher-3-wild-hunt/reviews", init[0], nil},
+		{"Unrecognized URL", testGameGet, "https://www.igdb.com/events/e3", nil, ErrIGDBURL},
+		{"No results", testFileEmptyArray, "https://www.igdb.com/games/missing", nil, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ent, err := c.ResolveURL(test.url)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ent, test.want) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ent, test.want)
+			}
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 type SocialMetric struct {
 	ID                 int                  `json:"id"`
 	Category           SocialMetricCategory `json:"category"`
-	CreatedAt          int                  `json:"created_at"`
+	CreatedAt          UnixTime             `json:"created_at"`
 	SocialMetricSource int                  `json:"social_metric_source"`
 	Value              int                  `json:"value"`
 }
@@ -0,0 +1,121 @@
+package igdb
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// imageKind is the kind of IGDB image a responsive image set is generated
+// for. Each kind is served in a different set of image sizes.
+type imageKind int
+
+// Available image kinds for responsive image sets
+const (
+	// KindCover is a Cover, served in cover sizes.
+	KindCover imageKind = iota
+	// KindScreenshot is a Screenshot or Artwork, served in screenshot and
+	// video resolution sizes.
+	KindScreenshot
+	// KindLogo is a CompanyLogo, PlatformLogo, or other logo, served in
+	// logo sizes.
+	KindLogo
+)
+
+// kindSizes holds the 1x image sizes suited to each image kind, from
+// smallest to largest. Cropped square sizes such as SizeThumb are left out
+// since they do not keep the aspect ratio of the image.
+var kindSizes = map[imageKind][]imageSize{
+	KindCover:      {SizeCoverSmall, SizeCoverBig},
+	KindScreenshot: {SizeScreenshotMed, SizeScreenshotBig, SizeScreenshotHuge, Size720p, Size1080p},
+	KindLogo:       {SizeLogoMed},
+}
+
+// SrcSetEntry is a single candidate image in a responsive image set.
+type SrcSetEntry struct {
+	URL    string
+	Size   imageSize
+	Ratio  int
+	Width  int
+	Height int
+}
+
+// SrcSet is a responsive image set ordered by width, such as for the srcset
+// attribute of an HTML img element.
+type SrcSet []SrcSetEntry
+
+// String returns the SrcSet as the value of a srcset attribute, with a
+// width descriptor for each URL (e.g. "a.jpg 90w, b.jpg 227w").
+func (s SrcSet) String() string {
+	cands := make([]string, len(s))
+	for i, e := range s {
+		cands[i] = e.URL + " " + strconv.Itoa(e.Width) + "w"
+	}
+
+	return strings.Join(cands, ", ")
+}
+
+// Largest returns the widest entry of the SrcSet, such as for the src
+// attribute of an HTML img element. The zero entry is returned if the
+// SrcSet is empty.
+func (s SrcSet) Largest() SrcSetEntry {
+	if len(s) == 0 {
+		return SrcSetEntry{}
+	}
+
+	return s[len(s)-1]
+}
+
+// SrcSet returns the responsive image set of this image for the provided
+// image kind, holding the URL of every image size suited to the kind at
+// display pixel ratios 1 and 2. The width and height of each entry are
+// those of the image scaled to fit within the maximum dimensions of its
+// size, keeping the aspect ratio given by the image's Width and Height. An
+// image is never scaled past its own dimensions, so sizes that would serve
+// the same width are only listed once. If the image's dimensions are
+// unknown, the maximum dimensions of each size are used instead.
+func (i Image) SrcSet(kind imageKind) (SrcSet, error) {
+	var set SrcSet
+	seen := make(map[int]bool)
+	for _, size := range kindSizes[kind] {
+		for _, ratio := range []int{1, 2} {
+			url, err := i.SizedURL(size, ratio)
+			if err != nil {
+				return nil, err
+			}
+
+			maxW, maxH := size.Dimensions()
+			w, h := i.fit(maxW*ratio, maxH*ratio)
+			if seen[w] {
+				continue
+			}
+			seen[w] = true
+
+			set = append(set, SrcSetEntry{URL: url, Size: size, Ratio: ratio, Width: w, Height: h})
+		}
+	}
+
+	sort.SliceStable(set, func(a, b int) bool {
+		return set[a].Width < set[b].Width
+	})
+
+	return set, nil
+}
+
+// fit returns the dimensions of this image scaled down to fit within the
+// provided maximum dimensions.
+func (i Image) fit(maxW, maxH int) (int, int) {
+	if i.Width <= 0 || i.Height <= 0 {
+		return maxW, maxH
+	}
+
+	w, h := i.Width, i.Height
+	if w > maxW {
+		w, h = maxW, h*maxW/w
+	}
+	if h > maxH {
+		w, h = w*maxH/h, maxH
+	}
+
+	return w, h
+}
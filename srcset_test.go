@@ -0,0 +1,105 @@
+package igdb
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestImage_SrcSet(t *testing.T) {
+	var tests = []struct {
+		name    string
+		image   Image
+		kind    imageKind
+		want    []SrcSetEntry
+		wantErr error
+	}{
+		{
+			"Cover with unknown dimensions",
+			Image{ImageID: "abc"},
+			KindCover,
+			[]SrcSetEntry{
+				{"https://images.igdb.com/igdb/image/upload/t_cover_small/abc.jpg", SizeCoverSmall, 1, 90, 128},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_small_2x/abc.jpg", SizeCoverSmall, 2, 180, 256},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_big/abc.jpg", SizeCoverBig, 1, 227, 320},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_big_2x/abc.jpg", SizeCoverBig, 2, 454, 640},
+			},
+			nil,
+		},
+		{
+			"Wide cover",
+			Image{ImageID: "abc", Width: 1000, Height: 1000},
+			KindCover,
+			[]SrcSetEntry{
+				{"https://images.igdb.com/igdb/image/upload/t_cover_small/abc.jpg", SizeCoverSmall, 1, 90, 90},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_small_2x/abc.jpg", SizeCoverSmall, 2, 180, 180},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_big/abc.jpg", SizeCoverBig, 1, 227, 227},
+				{"https://images.igdb.com/igdb/image/upload/t_cover_big_2x/abc.jpg", SizeCoverBig, 2, 454, 454},
+			},
+			nil,
+		},
+		{
+			"Small logo with alpha channel",
+			Image{ImageID: "abc", Width: 400, Height: 100, AlphaChannel: true},
+			KindLogo,
+			[]SrcSetEntry{
+				{"https://images.igdb.com/igdb/image/upload/t_logo_med/abc.png", SizeLogoMed, 1, 284, 71},
+				{"https://images.igdb.com/igdb/image/upload/t_logo_med_2x/abc.png", SizeLogoMed, 2, 400, 100},
+			},
+			nil,
+		},
+		{
+			"Screenshot",
+			Image{ImageID: "abc", Width: 1920, Height: 1080},
+			KindScreenshot,
+			[]SrcSetEntry{
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_med/abc.jpg", SizeScreenshotMed, 1, 569, 320},
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_big/abc.jpg", SizeScreenshotBig, 1, 889, 500},
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_med_2x/abc.jpg", SizeScreenshotMed, 2, 1138, 640},
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_huge/abc.jpg", SizeScreenshotHuge, 1, 1280, 720},
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_big_2x/abc.jpg", SizeScreenshotBig, 2, 1778, 1000},
+				{"https://images.igdb.com/igdb/image/upload/t_screenshot_huge_2x/abc.jpg", SizeScreenshotHuge, 2, 1920, 1080},
+			},
+			nil,
+		},
+		{"Blank ID", Image{}, KindCover, nil, ErrBlankID},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set, err := test.image.SrcSet(test.kind)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if len(set) != len(test.want) {
+				t.Fatalf("got: <%v>, want: <%v>", set, test.want)
+			}
+			for i := range test.want {
+				if set[i] != test.want[i] {
+					t.Errorf("got: <%v>, want: <%v>", set[i], test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSrcSet_String(t *testing.T) {
+	set := SrcSet{
+		{URL: "a.jpg", Width: 90},
+		{URL: "b.jpg", Width: 227},
+	}
+
+	want := "a.jpg 90w, b.jpg 227w"
+	if got := set.String(); got != want {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if got := set.Largest(); got != set[1] {
+		t.Errorf("got: <%v>, want: <%v>", got, set[1])
+	}
+
+	if got := (SrcSet{}).Largest(); got != (SrcSetEntry{}) {
+		t.Errorf("got: <%v>, want: <%v>", got, SrcSetEntry{})
+	}
+}
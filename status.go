@@ -1,16 +1,82 @@
 package igdb
 
-import "github.com/pkg/errors"
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// statusTimeLayout is the layout of the period timestamps in a UsageReport.
+const statusTimeLayout = "2006-01-02 15:04:05 -0700"
 
 //go:generate gomodifytags -file $GOFILE -struct Status -add-tags json -w
 
-// Status contains the usage report for the user's API key along with other
+// Status contains the usage reports for the user's API key along with other
 // metadata.
 // For more information visit: https://api-docs.igdb.com/#api-status
 type Status struct {
-	Authorized   bool        `json:"authorized"`
-	Plan         string      `json:"plan"`
-	UsageReports UsageReport `json:"usage_reports"`
+	Authorized   bool          `json:"authorized"`
+	Plan         string        `json:"plan"`
+	UsageReports []UsageReport `json:"usage_reports"`
+}
+
+// UnmarshalJSON fulfills the json.Unmarshaler interface. The usage reports
+// may be provided either as a list or as an object keyed by report name
+// (e.g. usage_report), in which case they are ordered by name.
+func (s *Status) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Authorized   bool            `json:"authorized"`
+		Plan         string          `json:"plan"`
+		UsageReports json.RawMessage `json:"usage_reports"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	s.Authorized = raw.Authorized
+	s.Plan = raw.Plan
+	s.UsageReports = nil
+
+	rep := bytes.TrimSpace(raw.UsageReports)
+	if len(rep) == 0 || string(rep) == "null" {
+		return nil
+	}
+
+	if rep[0] == openBracketASCII {
+		return json.Unmarshal(raw.UsageReports, &s.UsageReports)
+	}
+
+	var named map[string]UsageReport
+	if err := json.Unmarshal(raw.UsageReports, &named); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(named))
+	for n := range named {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		s.UsageReports = append(s.UsageReports, named[n])
+	}
+
+	return nil
+}
+
+// Report returns the usage report for the provided metric (e.g. hits). Nil
+// is returned if the Status has no report for the metric.
+func (s *Status) Report(metric string) *UsageReport {
+	for i := range s.UsageReports {
+		if s.UsageReports[i].Metric == metric {
+			return &s.UsageReports[i]
+		}
+	}
+
+	return nil
 }
 
 //go:generate gomodifytags -file $GOFILE -struct UsageReport -add-tags json -w
@@ -18,6 +84,16 @@ type Status struct {
 // UsageReport contains information and statistics for the the current user's
 // API usage in the current period.
 type UsageReport struct {
+	Metric       string    `json:"metric"`
+	Period       string    `json:"period"`
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
+	MaxValue     int       `json:"max_value"`
+	CurrentValue int       `json:"current_value"`
+}
+
+// usageReportJSON is the JSON representation of a UsageReport.
+type usageReportJSON struct {
 	Metric       string `json:"metric"`
 	Period       string `json:"period"`
 	PeriodStart  string `json:"period_start"`
@@ -26,8 +102,83 @@ type UsageReport struct {
 	CurrentValue int    `json:"current_value"`
 }
 
-// Status returns a usage report for the user's API key. It shows stats such as
-// requests made in the current period and when that period ends.
+// UnmarshalJSON fulfills the json.Unmarshaler interface.
+func (u *UsageReport) UnmarshalJSON(b []byte) error {
+	var raw usageReportJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	start, err := parseStatusTime(raw.PeriodStart)
+	if err != nil {
+		return err
+	}
+	end, err := parseStatusTime(raw.PeriodEnd)
+	if err != nil {
+		return err
+	}
+
+	*u = UsageReport{
+		Metric:       raw.Metric,
+		Period:       raw.Period,
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		MaxValue:     raw.MaxValue,
+		CurrentValue: raw.CurrentValue,
+	}
+
+	return nil
+}
+
+// MarshalJSON fulfills the json.Marshaler interface. Period timestamps are
+// encoded in the same layout used by the IGDB API.
+func (u UsageReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(usageReportJSON{
+		Metric:       u.Metric,
+		Period:       u.Period,
+		PeriodStart:  formatStatusTime(u.PeriodStart),
+		PeriodEnd:    formatStatusTime(u.PeriodEnd),
+		MaxValue:     u.MaxValue,
+		CurrentValue: u.CurrentValue,
+	})
+}
+
+// Remaining returns the number of requests left in the report's period.
+func (u UsageReport) Remaining() int {
+	if u.CurrentValue >= u.MaxValue {
+		return 0
+	}
+
+	return u.MaxValue - u.CurrentValue
+}
+
+// PercentUsed returns the percentage, from 0 to 100, of the report's maximum
+// value used in its period. Zero is returned if the report has no maximum.
+func (u UsageReport) PercentUsed() float64 {
+	if u.MaxValue <= 0 {
+		return 0
+	}
+
+	return float64(u.CurrentValue) / float64(u.MaxValue) * 100
+}
+
+// ResetsIn returns the time left until the report's period ends. Zero is
+// returned if the period has already ended or its end is unknown.
+func (u UsageReport) ResetsIn() time.Duration {
+	if u.PeriodEnd.IsZero() {
+		return 0
+	}
+
+	d := time.Until(u.PeriodEnd)
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+// Status returns the usage reports for the user's API key. They show stats
+// such as requests made in the current period and when that period ends.
 // For more information visit: https://api-docs.igdb.com/#api-status
 func (c *Client) Status() (*Status, error) {
 	var stat []*Status
@@ -39,3 +190,28 @@ func (c *Client) Status() (*Status, error) {
 
 	return stat[0], nil
 }
+
+// parseStatusTime parses a period timestamp from a UsageReport. An empty
+// timestamp is returned as the zero time.
+func parseStatusTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(statusTimeLayout, s)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "cannot parse usage report period %q", s)
+	}
+
+	return t, nil
+}
+
+// formatStatusTime formats a period timestamp for a UsageReport. The zero
+// time is formatted as an empty timestamp.
+func formatStatusTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(statusTimeLayout)
+}
@@ -3,30 +3,37 @@ package igdb
 import (
 	"encoding/json"
 	"github.com/pkg/errors"
-	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 const testStatus string = "test_data/status.json"
 
 func TestClient_Status(t *testing.T) {
-	f, err := ioutil.ReadFile(testStatus)
-	if err != nil {
-		t.Fatal(err)
+	init := &Status{
+		Authorized: true,
+		Plan:       "Free",
+		UsageReports: []UsageReport{
+			{
+				Metric:       "hits",
+				Period:       "month",
+				PeriodStart:  time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				MaxValue:     10000,
+				CurrentValue: 1,
+			},
+		},
 	}
 
-	init := make([]*Status, 1)
-	json.Unmarshal(f, &init)
-
 	tests := []struct {
 		name     string
 		file     string
 		wantStat *Status
 		wantErr  error
 	}{
-		{"Valid response", testStatus, init[0], nil},
+		{"Valid response", testStatus, init, nil},
 		{"Empty response", testFileEmpty, nil, errInvalidJSON},
 		{"No results", testFileEmptyArray, nil, ErrNoResults},
 	}
@@ -43,9 +50,141 @@ func TestClient_Status(t *testing.T) {
 				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
 			}
 
+			if stat != nil && test.wantStat != nil {
+				for i := range stat.UsageReports {
+					if !stat.UsageReports[i].PeriodEnd.Equal(test.wantStat.UsageReports[i].PeriodEnd) {
+						t.Errorf("got: <%v>, want: <%v>", stat.UsageReports[i].PeriodEnd, test.wantStat.UsageReports[i].PeriodEnd)
+					}
+					stat.UsageReports[i].PeriodStart = test.wantStat.UsageReports[i].PeriodStart
+					stat.UsageReports[i].PeriodEnd = test.wantStat.UsageReports[i].PeriodEnd
+				}
+			}
+
 			if !reflect.DeepEqual(stat, test.wantStat) {
 				t.Errorf("got: <%v>, \nwant: <%v>", stat, test.wantStat)
 			}
 		})
 	}
 }
+
+func TestStatus_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantMetrics []string
+		wantErr     bool
+	}{
+		{"Named reports", `{"usage_reports": {"usage_report": {"metric": "hits"}, "another_report": {"metric": "bytes"}}}`, []string{"bytes", "hits"}, false},
+		{"List of reports", `{"usage_reports": [{"metric": "hits"}, {"metric": "bytes"}]}`, []string{"hits", "bytes"}, false},
+		{"No reports", `{"usage_reports": null}`, nil, false},
+		{"Invalid period", `{"usage_reports": [{"period_end": "tomorrow"}]}`, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stat Status
+			err := json.Unmarshal([]byte(test.data), &stat)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var got []string
+			for _, r := range stat.UsageReports {
+				got = append(got, r.Metric)
+			}
+			if !reflect.DeepEqual(got, test.wantMetrics) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantMetrics)
+			}
+		})
+	}
+}
+
+func TestStatus_Report(t *testing.T) {
+	stat := &Status{UsageReports: []UsageReport{{Metric: "bytes"}, {Metric: "hits", MaxValue: 10}}}
+
+	if r := stat.Report("hits"); r == nil || r.MaxValue != 10 {
+		t.Errorf("got: <%v>, want: <%v>", r, stat.UsageReports[1])
+	}
+	if r := stat.Report("calls"); r != nil {
+		t.Errorf("got: <%v>, want: <%v>", r, nil)
+	}
+}
+
+func TestUsageReport_MarshalJSON(t *testing.T) {
+	want := UsageReport{
+		Metric:       "hits",
+		Period:       "month",
+		PeriodStart:  time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:    time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxValue:     10000,
+		CurrentValue: 1,
+	}
+
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got UsageReport
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Metric != want.Metric || !got.PeriodStart.Equal(want.PeriodStart) || !got.PeriodEnd.Equal(want.PeriodEnd) || got.CurrentValue != want.CurrentValue {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
+
+func TestUsageReport_Helpers(t *testing.T) {
+	tests := []struct {
+		name          string
+		rep           UsageReport
+		wantRemaining int
+		wantPercent   float64
+		wantResets    bool
+	}{
+		{"Partially used", UsageReport{MaxValue: 200, CurrentValue: 50, PeriodEnd: time.Now().Add(time.Hour)}, 150, 25, true},
+		{"Used up", UsageReport{MaxValue: 10, CurrentValue: 12, PeriodEnd: time.Now().Add(-time.Hour)}, 0, 120, false},
+		{"No maximum", UsageReport{}, 0, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.rep.Remaining(); got != test.wantRemaining {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantRemaining)
+			}
+			if got := test.rep.PercentUsed(); got != test.wantPercent {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantPercent)
+			}
+			if got := test.rep.ResetsIn(); (got > 0) != test.wantResets || got > time.Hour {
+				t.Errorf("got: <%v>, want resets: <%v>", got, test.wantResets)
+			}
+		})
+	}
+}
+
+func TestParseStatusTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{"Valid", "2018-12-01 00:00:00 +0000", time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Offset", "2018-12-01 02:00:00 +0200", time.Date(2018, 12, 1, 0, 0, 0, 0, time.UTC), false},
+		{"Empty", "", time.Time{}, false},
+		{"Invalid", "yesterday", time.Time{}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseStatusTime(test.s)
+			if (err != nil) != test.wantErr {
+				t.Errorf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
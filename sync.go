@@ -0,0 +1,205 @@
+package igdb
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// DefaultSyncLimit is the page size used by a Syncer when none is provided.
+// It is the same page size used by every other call that pages through
+// results.
+const DefaultSyncLimit = batchSize
+
+// syncOverlap is subtracted from the start of a crawl to form its watermark,
+// absorbing any clock skew between the local machine and the IGDB.
+const syncOverlap = 5 * time.Minute
+
+// SyncRecord is a single IGDB entity as stored by a SyncStore. Data holds the
+// entity exactly as it was returned by the IGDB.
+type SyncRecord struct {
+	ID        int             `json:"id"`
+	UpdatedAt int             `json:"updated_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// SyncStore is the local storage used by a Syncer to mirror IGDB entities.
+// Endpoints are identified by their path (e.g. "games/").
+type SyncStore interface {
+	// Put stores the provided records for the provided endpoint, replacing
+	// any record sharing the same ID. Put returns the number of records that
+	// were added and the number of existing records whose UpdatedAt changed.
+	Put(end string, recs []SyncRecord) (added int, changed int, err error)
+	// Watermark returns the watermark recorded under the provided key, or
+	// zero if none has been recorded. Keys are opaque strings built by the
+	// Syncer from the endpoint and the filters of each sync.
+	Watermark(key string) (int, error)
+	// SetWatermark records the provided watermark under the provided key.
+	SetWatermark(key string, updatedAt int) error
+}
+
+// SyncReport summarizes a single endpoint sync.
+type SyncReport struct {
+	Endpoint  string
+	Fetched   int
+	Added     int
+	Changed   int
+	Watermark int
+}
+
+// Syncer mirrors IGDB endpoints into a SyncStore. The first sync of an
+// endpoint crawls every entity using keyset pagination on the entity ID.
+// Later syncs only fetch entities whose updated_at has reached the
+// endpoint's watermark, which is the time the previous crawl started, less a
+// few minutes to absorb clock skew. Entities changed while a crawl is under
+// way are therefore fetched again by the next sync.
+//
+// Endpoints without an updated_at field are crawled in full on every sync.
+type Syncer struct {
+	client *Client
+	store  SyncStore
+	limit  int
+}
+
+// NewSyncer returns a Syncer that mirrors entities from the provided Client
+// into the provided SyncStore, fetching up to limit entities per request. If
+// limit is not positive, DefaultSyncLimit is used.
+func NewSyncer(client *Client, store SyncStore, limit int) *Syncer {
+	if limit <= 0 {
+		limit = DefaultSyncLimit
+	}
+
+	return &Syncer{client: client, store: store, limit: limit}
+}
+
+// Sync syncs each of the provided endpoints in order and returns a report
+// for each one. Syncing stops at the first endpoint that fails.
+func (s *Syncer) Sync(ends ...endpoint) ([]*SyncReport, error) {
+	var reps []*SyncReport
+	for _, end := range ends {
+		rep, err := s.SyncEndpoint(end)
+		if err != nil {
+			return reps, err
+		}
+		reps = append(reps, rep)
+	}
+
+	return reps, nil
+}
+
+// SyncEndpoint syncs a single endpoint. Provide the SetFilter functional
+// option to mirror only a subset of the endpoint's entities. Any fields,
+// order, limit, or offset options are overridden.
+//
+// Each set of filters has its own watermark, so syncing a subset of an
+// endpoint does not advance the watermark of the whole endpoint. A watermark
+// is only advanced once every page has been stored, so an interrupted sync is
+// resumed by the next call.
+func (s *Syncer) SyncEndpoint(end endpoint, opts ...Option) (*SyncReport, error) {
+	key, err := syncKey(end, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot sync '%s' endpoint", end)
+	}
+
+	mark, err := s.store.Watermark(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read watermark for '%s' endpoint", end)
+	}
+
+	start := time.Now().Add(-syncOverlap).Unix()
+	rep := &SyncReport{Endpoint: string(end), Watermark: int(start)}
+
+	if mark > 0 {
+		opts = append(opts, SetFilter("updated_at", OpGreaterThanEqual, strconv.Itoa(mark)))
+	}
+
+	last := -1
+	for {
+		page := append(opts[:len(opts):len(opts)],
+			SetFields("*"),
+			SetFilter("id", OpGreaterThan, strconv.Itoa(last)),
+			SetOrder("id", OrderAscending),
+			SetLimit(s.limit),
+		)
+
+		var raw []json.RawMessage
+		err := s.client.get(end, &raw, page...)
+		if errors.Cause(err) == ErrNoResults {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot sync '%s' endpoint", end)
+		}
+
+		recs, err := newSyncRecords(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot sync '%s' endpoint", end)
+		}
+
+		added, changed, err := s.store.Put(string(end), recs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot store '%s' entities", end)
+		}
+
+		rep.Fetched += len(recs)
+		rep.Added += added
+		rep.Changed += changed
+		for _, r := range recs {
+			if r.ID > last {
+				last = r.ID
+			}
+		}
+
+		if len(recs) < s.limit {
+			break
+		}
+	}
+
+	if err := s.store.SetWatermark(key, rep.Watermark); err != nil {
+		return nil, errors.Wrapf(err, "cannot write watermark for '%s' endpoint", end)
+	}
+
+	return rep, nil
+}
+
+// syncKey returns the watermark key of a sync of the provided endpoint
+// restricted by the provided options. Unrestricted syncs are keyed by the
+// endpoint alone.
+func syncKey(end endpoint, opts ...Option) (string, error) {
+	if len(opts) == 0 {
+		return string(end), nil
+	}
+
+	unwrapped, err := unwrapOptions(opts...)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid sync options")
+	}
+
+	qry, err := apicalypse.Query(unwrapped...)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot build sync query")
+	}
+
+	return string(end) + "?" + qry, nil
+}
+
+// newSyncRecords wraps the provided raw entities in SyncRecords.
+func newSyncRecords(raw []json.RawMessage) ([]SyncRecord, error) {
+	recs := make([]SyncRecord, len(raw))
+	for i, r := range raw {
+		var meta struct {
+			ID        int `json:"id"`
+			UpdatedAt int `json:"updated_at"`
+		}
+		if err := json.Unmarshal(r, &meta); err != nil {
+			return nil, errors.Wrap(errInvalidJSON, err.Error())
+		}
+
+		recs[i] = SyncRecord{ID: meta.ID, UpdatedAt: meta.UpdatedAt, Data: r}
+	}
+
+	return recs, nil
+}
@@ -0,0 +1,229 @@
+package igdb_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gotomgo/igdb"
+	"github.com/gotomgo/igdb/igdbtest"
+)
+
+// checkSyncWatermark checks that the watermark of the provided report lies
+// at or before the start of the sync, then clears it for comparison.
+func checkSyncWatermark(t *testing.T, rep *igdb.SyncReport, start int64) {
+	t.Helper()
+
+	if rep.Watermark <= 0 || int64(rep.Watermark) > start {
+		t.Errorf("got: <%v>, want a watermark at or before <%v>", rep.Watermark, start)
+	}
+	rep.Watermark = 0
+}
+
+func TestSyncer_SyncEndpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdbsync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	err = srv.Load(string(igdb.EndpointGenre), strings.NewReader(`[
+		{"id": 1, "name": "Shooter", "updated_at": 100},
+		{"id": 2, "name": "Puzzle", "updated_at": 200},
+		{"id": 3, "name": "Racing", "updated_at": 300}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := igdb.NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := igdb.NewSyncer(srv.NewClient(), store, 2)
+
+	start := time.Now().Unix()
+	rep, err := s.SyncEndpoint(igdb.EndpointGenre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSyncWatermark(t, rep, start)
+	want := igdb.SyncReport{Endpoint: string(igdb.EndpointGenre), Fetched: 3, Added: 3}
+	if *rep != want {
+		t.Errorf("got: <%+v>, want: <%+v>", *rep, want)
+	}
+
+	now := time.Now().Unix()
+	err = srv.Load(string(igdb.EndpointGenre), strings.NewReader(fmt.Sprintf(`[
+		{"id": 2, "name": "Puzzler", "updated_at": %d},
+		{"id": 4, "name": "Sport", "updated_at": %d}
+	]`, now, now)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the store to check that watermarks and entities persist.
+	store, err = igdb.NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start = time.Now().Unix()
+	reps, err := igdb.NewSyncer(srv.NewClient(), store, 2).Sync(igdb.EndpointGenre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reps) != 1 {
+		t.Fatalf("got: <%v> reports, want: <%v>", len(reps), 1)
+	}
+	checkSyncWatermark(t, reps[0], start)
+	want = igdb.SyncReport{Endpoint: string(igdb.EndpointGenre), Fetched: 2, Added: 1, Changed: 1}
+	if *reps[0] != want {
+		t.Fatalf("got: <%+v>, want: <%+v>", *reps[0], want)
+	}
+
+	recs, err := store.All(string(igdb.EndpointGenre))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 4 || !strings.Contains(string(recs[1].Data), "Puzzler") {
+		t.Errorf("got: <%v>, want 4 records with updated genre 2", recs)
+	}
+
+	b, err := ioutil.ReadFile(dir + "/genres.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The new and changed genres are appended after the first three.
+	if n := strings.Count(string(b), "\n"); n != 5 {
+		t.Errorf("got: <%v> lines, want: <%v>", n, 5)
+	}
+}
+
+// changingStore is a SyncStore that calls change after its first Put.
+type changingStore struct {
+	*igdb.JSONLStore
+	change func()
+}
+
+func (s *changingStore) Put(end string, recs []igdb.SyncRecord) (int, int, error) {
+	added, changed, err := s.JSONLStore.Put(end, recs)
+	if s.change != nil {
+		s.change()
+		s.change = nil
+	}
+
+	return added, changed, err
+}
+
+func TestSyncer_SyncEndpointChangedDuringCrawl(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdbsync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	err = srv.Load(string(igdb.EndpointGenre), strings.NewReader(`[
+		{"id": 1, "name": "Shooter", "updated_at": 100},
+		{"id": 2, "name": "Puzzle", "updated_at": 200},
+		{"id": 3, "name": "Racing", "updated_at": 300}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	js, err := igdb.NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Genre 1 changes after the first page is stored, followed by genre 3,
+	// which is then crawled with an updated_at later than genre 1's.
+	now := time.Now().Unix()
+	store := &changingStore{JSONLStore: js, change: func() {
+		err := srv.Add(string(igdb.EndpointGenre),
+			map[string]interface{}{"id": 1, "name": "Shooters", "updated_at": now},
+			map[string]interface{}{"id": 3, "name": "Racing", "updated_at": now + 1},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}}
+
+	s := igdb.NewSyncer(srv.NewClient(), store, 2)
+	if _, err := s.SyncEndpoint(igdb.EndpointGenre); err != nil {
+		t.Fatal(err)
+	}
+
+	rep, err := s.SyncEndpoint(igdb.EndpointGenre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Changed != 1 {
+		t.Errorf("got: <%v> changed, want: <%v>", rep.Changed, 1)
+	}
+
+	rec, err := js.Get(string(igdb.EndpointGenre), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(rec.Data), "Shooters") {
+		t.Errorf("got: <%s>, want genre 1 changed during the crawl", rec.Data)
+	}
+}
+
+func TestSyncer_SyncEndpointFiltered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "igdbsync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srv := igdbtest.NewServer()
+	defer srv.Close()
+
+	err = srv.Load(string(igdb.EndpointGenre), strings.NewReader(`[
+		{"id": 1, "name": "Shooter", "updated_at": 100},
+		{"id": 2, "name": "Puzzle", "updated_at": 200}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := igdb.NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := igdb.NewSyncer(srv.NewClient(), store, 2)
+	if _, err := s.SyncEndpoint(igdb.EndpointGenre, igdb.SetFilter("id", igdb.OpEquals, "1")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A sync of a subset leaves the whole endpoint's watermark untouched.
+	mark, err := store.Watermark(string(igdb.EndpointGenre))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mark != 0 {
+		t.Errorf("got: <%v>, want: <%v>", mark, 0)
+	}
+
+	rep, err := s.SyncEndpoint(igdb.EndpointGenre)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Fetched != 2 || rep.Added != 1 {
+		t.Errorf("got: <%+v>, want 2 fetched and 1 added", *rep)
+	}
+}
@@ -0,0 +1,352 @@
+package igdb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// watermarkFile is the name of the file a JSONLStore keeps its watermarks in.
+const watermarkFile = "watermarks.json"
+
+// JSONLStore is a SyncStore that keeps each endpoint's entities in a JSON
+// lines file within a directory. Each line of an endpoint's file holds a
+// single entity as returned by the IGDB. Endpoint files are named after their
+// endpoint path (e.g. games.jsonl, private_people.jsonl).
+//
+// New and changed entities are appended to the end of their endpoint's file,
+// so an entity may be held by more than one line; the last line wins. Once
+// the superseded lines of a file outnumber its entities, the file is
+// compacted by rewriting it with a single line per entity, ordered by ID.
+//
+// A JSONLStore keeps only the ID and update time of each entity in memory and
+// is safe for concurrent use.
+type JSONLStore struct {
+	dir   string
+	mu    sync.Mutex
+	index map[string]*jsonlIndex
+	marks map[string]int
+}
+
+// jsonlIndex describes the file of a single endpoint of a JSONLStore.
+type jsonlIndex struct {
+	// updated holds the update time of each stored entity by ID.
+	updated map[int]int
+	// lines is the number of lines in the file, including superseded ones.
+	lines int
+}
+
+// NewJSONLStore returns a JSONLStore backed by the provided directory. The
+// directory is created if it does not exist.
+func NewJSONLStore(dir string) (*JSONLStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "cannot create store directory '%s'", dir)
+	}
+
+	s := &JSONLStore{
+		dir:   dir,
+		index: make(map[string]*jsonlIndex),
+		marks: make(map[string]int),
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, watermarkFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "cannot read watermarks")
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &s.marks); err != nil {
+			return nil, errors.Wrap(errInvalidJSON, err.Error())
+		}
+	}
+
+	return s, nil
+}
+
+// Put fulfills the SyncStore interface. Only new and changed entities are
+// written; they are appended to the endpoint's file.
+func (s *JSONLStore) Put(end string, recs []SyncRecord) (added int, changed int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx, err := s.loadIndex(end)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var buf bytes.Buffer
+	pending := make(map[int]int)
+	for _, r := range recs {
+		old, ok := pending[r.ID]
+		if !ok {
+			old, ok = idx.updated[r.ID]
+		}
+		switch {
+		case !ok:
+			added++
+		case old != r.UpdatedAt:
+			changed++
+		default:
+			continue
+		}
+
+		if err := json.Compact(&buf, r.Data); err != nil {
+			return 0, 0, errors.Wrap(errInvalidJSON, err.Error())
+		}
+		buf.WriteByte('\n')
+		pending[r.ID] = r.UpdatedAt
+	}
+
+	if len(pending) == 0 {
+		return 0, 0, nil
+	}
+
+	if err := appendFile(s.path(end), buf.Bytes()); err != nil {
+		return 0, 0, err
+	}
+
+	for id, updatedAt := range pending {
+		idx.updated[id] = updatedAt
+	}
+	idx.lines += bytes.Count(buf.Bytes(), []byte{'\n'})
+
+	if idx.lines > 2*len(idx.updated) {
+		if err := s.compact(end); err != nil {
+			return added, changed, err
+		}
+	}
+
+	return added, changed, nil
+}
+
+// Compact rewrites the file of the provided endpoint with a single line per
+// stored entity, ordered by ID. Compact is called by Put whenever the
+// superseded lines of a file outnumber its entities.
+func (s *JSONLStore) Compact(end string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.compact(end)
+}
+
+// Watermark fulfills the SyncStore interface.
+func (s *JSONLStore) Watermark(key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.marks[key], nil
+}
+
+// SetWatermark fulfills the SyncStore interface.
+func (s *JSONLStore) SetWatermark(key string, updatedAt int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marks[key] = updatedAt
+
+	b, err := json.MarshalIndent(s.marks, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "cannot encode watermarks")
+	}
+
+	return writeFileAtomic(filepath.Join(s.dir, watermarkFile), b)
+}
+
+// Get returns the stored entity with the provided ID from the provided
+// endpoint. If no such entity is stored, ErrNoResults is returned.
+func (s *JSONLStore) Get(end string, id int) (*SyncRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rec *SyncRecord
+	err := s.scan(end, func(r SyncRecord) {
+		if r.ID == id {
+			rec = &r
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, ErrNoResults
+	}
+
+	return rec, nil
+}
+
+// All returns every stored entity of the provided endpoint ordered by ID.
+func (s *JSONLStore) All(end string) ([]SyncRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur, err := s.read(end)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedRecords(cur), nil
+}
+
+// loadIndex returns the index of the provided endpoint, building it from
+// disk on first use. The caller must hold the lock.
+func (s *JSONLStore) loadIndex(end string) (*jsonlIndex, error) {
+	if idx, ok := s.index[end]; ok {
+		return idx, nil
+	}
+
+	idx := &jsonlIndex{updated: make(map[int]int)}
+	err := s.scan(end, func(r SyncRecord) {
+		idx.updated[r.ID] = r.UpdatedAt
+		idx.lines++
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.index[end] = idx
+	return idx, nil
+}
+
+// read returns the latest line of every entity of the provided endpoint.
+// The caller must hold the lock.
+func (s *JSONLStore) read(end string) (map[int]SyncRecord, error) {
+	cur := make(map[int]SyncRecord)
+	err := s.scan(end, func(r SyncRecord) {
+		cur[r.ID] = r
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cur, nil
+}
+
+// scan calls fn with every line of the file of the provided endpoint in
+// order. A missing file holds no lines. The caller must hold the lock.
+func (s *JSONLStore) scan(end string, fn func(SyncRecord)) error {
+	f, err := os.Open(s.path(end))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot open store for '%s' endpoint", end)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, 16*1024*1024)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		recs, err := newSyncRecords([]json.RawMessage{append(json.RawMessage(nil), line...)})
+		if err != nil {
+			return errors.Wrapf(err, "cannot read store for '%s' endpoint", end)
+		}
+		fn(recs[0])
+	}
+	if err := sc.Err(); err != nil {
+		return errors.Wrapf(err, "cannot read store for '%s' endpoint", end)
+	}
+
+	return nil
+}
+
+// compact replaces the file of the provided endpoint with the latest line of
+// each of its entities, ordered by ID. The caller must hold the lock.
+func (s *JSONLStore) compact(end string) error {
+	cur, err := s.read(end)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, r := range sortedRecords(cur) {
+		if err := json.Compact(&buf, r.Data); err != nil {
+			return errors.Wrap(errInvalidJSON, err.Error())
+		}
+		buf.WriteByte('\n')
+	}
+
+	if err := writeFileAtomic(s.path(end), buf.Bytes()); err != nil {
+		return err
+	}
+
+	idx := &jsonlIndex{updated: make(map[int]int, len(cur)), lines: len(cur)}
+	for id, r := range cur {
+		idx.updated[id] = r.UpdatedAt
+	}
+	s.index[end] = idx
+
+	return nil
+}
+
+// path returns the location of the file for the provided endpoint.
+func (s *JSONLStore) path(end string) string {
+	name := strings.Replace(strings.Trim(end, "/"), "/", "_", -1)
+	return filepath.Join(s.dir, name+".jsonl")
+}
+
+// sortedRecords returns the provided records ordered by ID.
+func sortedRecords(m map[int]SyncRecord) []SyncRecord {
+	recs := make([]SyncRecord, 0, len(m))
+	for _, r := range m {
+		recs = append(recs, r)
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].ID < recs[j].ID })
+
+	return recs
+}
+
+// writeFileAtomic writes the provided data to a temporary file and renames
+// it over filename so readers never observe a partial write.
+func writeFileAtomic(filename string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename)+".tmp")
+	if err != nil {
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+
+	return errors.Wrapf(os.Rename(tmp.Name(), filename), "cannot write '%s'", filename)
+}
+
+// appendFile appends the provided data to filename, creating it if needed.
+// If the data cannot be written in full, the file is truncated back to its
+// previous size so it never holds a partial line.
+func appendFile(filename string, data []byte) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Truncate(fi.Size())
+		f.Close()
+		return errors.Wrapf(err, "cannot write '%s'", filename)
+	}
+
+	return errors.Wrapf(f.Close(), "cannot write '%s'", filename)
+}
@@ -0,0 +1,152 @@
+package igdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestJSONLStore_Put(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jsonlstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		recs        []SyncRecord
+		wantAdded   int
+		wantChanged int
+	}{
+		{"New records", []SyncRecord{{ID: 2, UpdatedAt: 1, Data: json.RawMessage(`{"id":2,"updated_at":1}`)}, {ID: 1, UpdatedAt: 1, Data: json.RawMessage(`{"id":1,"updated_at":1}`)}}, 2, 0},
+		{"Unchanged record", []SyncRecord{{ID: 1, UpdatedAt: 1, Data: json.RawMessage(`{"id":1,"updated_at":1}`)}}, 0, 0},
+		{"Changed record", []SyncRecord{{ID: 1, UpdatedAt: 5, Data: json.RawMessage(`{"id":1,"updated_at":5}`)}}, 0, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			added, changed, err := s.Put(string(EndpointPerson), test.recs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if added != test.wantAdded || changed != test.wantChanged {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", added, changed, test.wantAdded, test.wantChanged)
+			}
+		})
+	}
+
+	file := filepath.Join(dir, "private_people.jsonl")
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\"id\":2,\"updated_at\":1}\n{\"id\":1,\"updated_at\":1}\n{\"id\":1,\"updated_at\":5}\n"; string(b) != want {
+		t.Errorf("got: <%v>, want: <%v>", string(b), want)
+	}
+
+	r, err := s.Get(string(EndpointPerson), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.UpdatedAt != 5 {
+		t.Errorf("got: <%v>, want: <%v>", r.UpdatedAt, 5)
+	}
+
+	if err := s.Compact(string(EndpointPerson)); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err = ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\"id\":1,\"updated_at\":5}\n{\"id\":2,\"updated_at\":1}\n"; string(b) != want {
+		t.Errorf("got: <%v>, want: <%v>", string(b), want)
+	}
+
+	if _, err := s.Get(string(EndpointPerson), 3); errors.Cause(err) != ErrNoResults {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrNoResults)
+	}
+}
+
+func TestJSONLStore_PutCompacts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jsonlstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		rec := SyncRecord{ID: 1, UpdatedAt: i, Data: json.RawMessage(fmt.Sprintf(`{"id":1,"updated_at":%d}`, i))}
+		if _, _, err := s.Put(string(EndpointGenre), []SyncRecord{rec}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "genres.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\"id\":1,\"updated_at\":3}\n"; string(b) != want {
+		t.Errorf("got: <%v>, want: <%v>", string(b), want)
+	}
+
+	s, err = NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	added, changed, err := s.Put(string(EndpointGenre), []SyncRecord{{ID: 1, UpdatedAt: 3, Data: json.RawMessage(`{"id":1,"updated_at":3}`)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 0 || changed != 0 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", added, changed, 0, 0)
+	}
+}
+
+func TestJSONLStore_Watermark(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jsonlstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.SetWatermark(string(EndpointGame), 42); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewJSONLStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mark, err := s.Watermark(string(EndpointGame))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mark != 42 {
+		t.Errorf("got: <%v>, want: <%v>", mark, 42)
+	}
+}
@@ -2,42 +2,168 @@ package igdb
 
 import (
 	"strconv"
+
+	"github.com/pkg/errors"
 )
 
 // Tag is a generated number that represents a specific IGDB object. Tag
 // provides a quick and compact way to do complex filtering on the IGDB API.
 type Tag int
 
-// tagType represents the IGDB Object ID of a particular IGDB object type.
-type tagType int
+// TagType represents the IGDB Object ID of a particular IGDB object type.
+type TagType int
+
+// tagTypeShift is the number of bits the TagType is shifted left in a Tag.
+// The bits below it hold the object ID.
+const tagTypeShift = 28
 
-// These tagTypes correspond to their respective IGDB Object Type IDs.
+// tagObjectMask masks the object ID bits of a Tag.
+const tagObjectMask = 1<<tagTypeShift - 1
+
+// These TagTypes correspond to their respective IGDB Object Type IDs. They
+// are every object type the IGDB generates tags for.
 //
 // For the list of these IDs and other information,
 // visit: https://igdb.github.io/api/references/tag-numbers/
 const (
-	TagTheme tagType = iota
+	TagTheme TagType = iota
 	TagGenre
 	TagKeyword
 	TagGame
 	TagPerspective
 )
 
+// TagTypes lists every TagType in order of their IGDB Object Type IDs.
+var TagTypes = []TagType{TagTheme, TagGenre, TagKeyword, TagGame, TagPerspective}
+
+// String returns the name of the TagType.
+func (t TagType) String() string {
+	switch t {
+	case TagTheme:
+		return "theme"
+	case TagGenre:
+		return "genre"
+	case TagKeyword:
+		return "keyword"
+	case TagGame:
+		return "game"
+	case TagPerspective:
+		return "player_perspective"
+	}
+
+	return "TagType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// IsKnown returns true if the TagType is one of TagTypes.
+func (t TagType) IsKnown() bool {
+	return t >= TagTheme && t <= TagPerspective
+}
+
 // GenerateTag uses the ID of an IGDB object type and the ID of an IGDB
 // object to generate a Tag addressed to that object. Negative ID values
-// are considered invalid.
-func GenerateTag(typeID tagType, objectID int) (Tag, error) {
+// are considered invalid, as are object IDs too large to fit in a Tag.
+func GenerateTag(typeID TagType, objectID int) (Tag, error) {
 	if typeID < 0 || objectID < 0 {
 		return 0, ErrNegativeID
 	}
+	if objectID > tagObjectMask {
+		return 0, ErrOutOfRange
+	}
 
-	tag := int(typeID) << 28
+	tag := int(typeID) << tagTypeShift
 	tag |= objectID
 
 	return Tag(tag), nil
 }
 
+// Decode returns the type and object ID of the IGDB object the Tag is
+// addressed to. Decode is the inverse of GenerateTag.
+func (t Tag) Decode() (TagType, int) {
+	return TagType(int(t) >> tagTypeShift), int(t) & tagObjectMask
+}
+
+// Type returns the type of the IGDB object the Tag is addressed to.
+func (t Tag) Type() TagType {
+	typ, _ := t.Decode()
+	return typ
+}
+
+// ObjectID returns the ID of the IGDB object the Tag is addressed to.
+func (t Tag) ObjectID() int {
+	_, id := t.Decode()
+	return id
+}
+
 // String returns the provided Tag as a string.
 func (t Tag) String() string {
 	return strconv.Itoa(int(t))
 }
+
+// ResolvedTags contains the IGDB objects addressed by a list of Tags.
+type ResolvedTags struct {
+	Themes             []*Theme
+	Genres             []*Genre
+	Keywords           []*Keyword
+	Games              []*Game
+	PlayerPerspectives []*PlayerPerspective
+}
+
+// ResolveTags retrieves the IGDB objects addressed by the provided Tags.
+// The object IDs of each TagType are retrieved in batches using List, and
+// the provided functional options, such as SetFields, are applied to every
+// batch. Tags of unknown types and tags addressed to objects that cannot be
+// found are ignored.
+func (c *Client) ResolveTags(tags []Tag, opts ...Option) (*ResolvedTags, error) {
+	ids := make(map[TagType][]int)
+	seen := make(map[Tag]bool)
+	for _, t := range tags {
+		if t < 0 || seen[t] {
+			continue
+		}
+		seen[t] = true
+
+		typ, id := t.Decode()
+		ids[typ] = append(ids[typ], id)
+	}
+
+	res := &ResolvedTags{}
+	for _, typ := range TagTypes {
+		err := batchIDs(ids[typ], func(batch []int) error {
+			bopts := append([]Option{SetLimit(len(batch))}, opts...)
+
+			var err error
+			switch typ {
+			case TagTheme:
+				var v []*Theme
+				v, err = c.Themes.List(batch, bopts...)
+				res.Themes = append(res.Themes, v...)
+			case TagGenre:
+				var v []*Genre
+				v, err = c.Genres.List(batch, bopts...)
+				res.Genres = append(res.Genres, v...)
+			case TagKeyword:
+				var v []*Keyword
+				v, err = c.Keywords.List(batch, bopts...)
+				res.Keywords = append(res.Keywords, v...)
+			case TagGame:
+				var v []*Game
+				v, err = c.Games.List(batch, bopts...)
+				res.Games = append(res.Games, v...)
+			case TagPerspective:
+				var v []*PlayerPerspective
+				v, err = c.PlayerPerspectives.List(batch, bopts...)
+				res.PlayerPerspectives = append(res.PlayerPerspectives, v...)
+			}
+
+			if errors.Cause(err) == ErrNoResults {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot resolve %s tags", typ)
+		}
+	}
+
+	return res, nil
+}
@@ -1,6 +1,9 @@
 package igdb
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -8,7 +11,7 @@ import (
 func TestGenerateTag(t *testing.T) {
 	var tagTests = []struct {
 		Name     string
-		TypeID   tagType
+		TypeID   TagType
 		ObjectID int
 		ExpTag   Tag
 		ExpErr   error
@@ -16,6 +19,7 @@ func TestGenerateTag(t *testing.T) {
 		{"ObjectID at zero", TagTheme, 0, 0, nil},
 		{"ObjectID within range", TagGenre, 5, 268435461, nil},
 		{"OjectID below range", TagKeyword, -1234, 0, ErrNegativeID},
+		{"OjectID above range", TagKeyword, tagObjectMask + 1, 0, ErrOutOfRange},
 	}
 
 	for _, tt := range tagTests {
@@ -55,3 +59,103 @@ func TestTagString(t *testing.T) {
 		})
 	}
 }
+
+func TestTag_Decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      TagType
+		objectID int
+	}{
+		{"Theme", TagTheme, 0},
+		{"Genre", TagGenre, 5},
+		{"Keyword", TagKeyword, 1234},
+		{"Game", TagGame, 1942},
+		{"Perspective", TagPerspective, tagObjectMask},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tag, err := GenerateTag(test.typ, test.objectID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			typ, id := tag.Decode()
+			if typ != test.typ || id != test.objectID {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", typ, id, test.typ, test.objectID)
+			}
+			if tag.Type() != test.typ || tag.ObjectID() != test.objectID {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", tag.Type(), tag.ObjectID(), test.typ, test.objectID)
+			}
+		})
+	}
+}
+
+func TestTagType_String(t *testing.T) {
+	var got []string
+	for _, typ := range TagTypes {
+		if !typ.IsKnown() {
+			t.Errorf("got: <%v>, want known", typ)
+		}
+		got = append(got, typ.String())
+	}
+
+	want := []string{"theme", "genre", "keyword", "game", "player_perspective"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+
+	if TagType(9).IsKnown() || TagType(9).String() != "TagType(9)" {
+		t.Errorf("got: <%v>, want an unknown TagType", TagType(9))
+	}
+}
+
+func TestClient_ResolveTags(t *testing.T) {
+	var calls []string
+	ts, c, _ := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		calls = append(calls, r.URL.Path+" "+string(b))
+
+		switch r.URL.Path {
+		case "/" + string(EndpointGenre):
+			fmt.Fprint(w, `[{"id": 5, "name": "Puzzle"}]`)
+		case "/" + string(EndpointGame):
+			fmt.Fprint(w, `[{"id": 1942, "name": "The Witcher 3"}, {"id": 7, "name": "Woodpunk"}]`)
+		default:
+			fmt.Fprint(w, "[]")
+		}
+	})
+	defer ts.Close()
+
+	var tags []Tag
+	for _, v := range []struct {
+		typ TagType
+		id  int
+	}{{TagGenre, 5}, {TagGame, 1942}, {TagGame, 7}, {TagGame, 7}, {TagKeyword, 3}, {9, 1}} {
+		tag, err := GenerateTag(v.typ, v.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tags = append(tags, tag)
+	}
+
+	res, err := c.ResolveTags(tags, SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Genres) != 1 || res.Genres[0].Name != "Puzzle" {
+		t.Errorf("got: <%v>, want a single Genre", res.Genres)
+	}
+	if len(res.Games) != 2 || len(res.Keywords) != 0 || len(res.Themes) != 0 {
+		t.Errorf("got: <%+v>, want two Games", res)
+	}
+
+	want := []string{
+		"/" + string(EndpointGenre) + " fields name; limit 1; where id = (5); ",
+		"/" + string(EndpointKeyword) + " fields name; limit 1; where id = (3); ",
+		"/" + string(EndpointGame) + " fields name; limit 2; where id = (1942,7); ",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got: <%q>, want: <%q>", calls, want)
+	}
+}
@@ -0,0 +1,228 @@
+package igdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Attribute is a key and value describing a span or measurement.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer creates a Span for each IGDB API call. Tracer is shaped after the
+// OpenTelemetry tracing API so that it can be backed by OpenTelemetry, or
+// any other tracing library, without the igdb package depending on it.
+type Tracer interface {
+	// Start begins a new Span with the provided name as a child of any
+	// span in the provided context.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span records a single IGDB API call.
+type Span interface {
+	// SetAttributes sets the provided attributes on the Span.
+	SetAttributes(attrs ...Attribute)
+	// RecordError records the provided error as having occurred during the Span.
+	RecordError(err error)
+	// End completes the Span.
+	End()
+}
+
+// Meter creates the instruments used to measure IGDB API calls. Like Tracer,
+// Meter can be backed by OpenTelemetry, Prometheus, or any other metrics
+// library.
+type Meter interface {
+	// Counter returns the Counter with the provided name.
+	Counter(name string) Counter
+	// Histogram returns the Histogram with the provided name.
+	Histogram(name string) Histogram
+}
+
+// Counter is a monotonically increasing metric.
+type Counter interface {
+	Add(v float64, attrs ...Attribute)
+}
+
+// Histogram records a distribution of values.
+type Histogram interface {
+	Record(v float64, attrs ...Attribute)
+}
+
+// Attribute keys set by Instrument.
+const (
+	AttrEndpoint    = "igdb.endpoint"
+	AttrQueryHash   = "igdb.query.hash"
+	AttrResultCount = "igdb.result.count"
+	AttrStatusCode  = "http.status_code"
+	AttrRetryCount  = "igdb.retry.count"
+	AttrErrorType   = "error.type"
+)
+
+// Metric names recorded by Instrument.
+const (
+	// MetricRequests counts every API call.
+	MetricRequests = "igdb.requests"
+	// MetricErrors counts failed API calls by error type.
+	MetricErrors = "igdb.errors"
+	// MetricDuration records the duration of each API call in seconds.
+	MetricDuration = "igdb.request.duration"
+	// MetricBytes counts the bytes received in response bodies.
+	MetricBytes = "igdb.response.bytes"
+	// MetricQuota counts the requests sent to the IGDB, each of which is
+	// counted against the API key's quota. Calls answered without sending
+	// a request, such as from a cache, are not counted.
+	MetricQuota = "igdb.quota.used"
+)
+
+// WithTracer is a ClientOption that traces every API call made by the
+// Client with the provided Tracer.
+func WithTracer(t Tracer) ClientOption {
+	return func(c *Client) {
+		c.tracer = t
+	}
+}
+
+// WithMeter is a ClientOption that measures every API call made by the
+// Client with the provided Meter.
+func WithMeter(m Meter) ClientOption {
+	return func(c *Client) {
+		c.meter = m
+	}
+}
+
+// Instrument returns a Middleware that traces and measures each Call with
+// the provided Tracer and Meter, either of which may be nil. Clients created
+// with WithTracer or WithMeter install it as their outermost middleware so
+// that retries and cached responses are reflected in its spans and metrics.
+func Instrument(t Tracer, m Meter) Middleware {
+	var requests, errs, quota, bytes Counter
+	var duration Histogram
+	if m != nil {
+		requests = m.Counter(MetricRequests)
+		errs = m.Counter(MetricErrors)
+		quota = m.Counter(MetricQuota)
+		bytes = m.Counter(MetricBytes)
+		duration = m.Histogram(MetricDuration)
+	}
+
+	return func(next Handler) Handler {
+		return func(call *Call) error {
+			end := Attribute{AttrEndpoint, string(call.Endpoint)}
+
+			var span Span
+			if t != nil {
+				var ctx context.Context
+				ctx, span = t.Start(call.Request.Context(), "igdb "+strings.TrimSuffix(string(call.Endpoint), "/"))
+				call.Request = call.Request.WithContext(ctx)
+				span.SetAttributes(end, Attribute{AttrQueryHash, queryHash(call.Query)})
+			}
+
+			start := time.Now()
+			err := next(call)
+			elapsed := time.Since(start)
+
+			if span != nil {
+				span.SetAttributes(
+					Attribute{AttrResultCount, resultCount(call.Result, err)},
+					Attribute{AttrStatusCode, call.StatusCode},
+					Attribute{AttrRetryCount, retries(call)},
+				)
+				if err != nil {
+					span.SetAttributes(Attribute{AttrErrorType, ErrorType(err)})
+					span.RecordError(err)
+				}
+				span.End()
+			}
+
+			if m != nil {
+				requests.Add(1, end)
+				duration.Record(elapsed.Seconds(), end)
+				bytes.Add(float64(call.BytesReceived), end)
+				quota.Add(float64(call.Attempts), end)
+				if err != nil {
+					errs.Add(1, end, Attribute{AttrErrorType, ErrorType(err)})
+				}
+			}
+
+			return err
+		}
+	}
+}
+
+// ErrorType returns a short, stable name for the kind of the provided error
+// (e.g. no_results or unauthorized) suitable for use as a metric attribute.
+func ErrorType(err error) string {
+	switch c := errors.Cause(err).(type) {
+	case nil:
+		return ""
+	case ServerError:
+		switch c.Status {
+		case ErrBadRequest.Status:
+			return "bad_request"
+		case ErrUnauthorized.Status:
+			return "unauthorized"
+		case ErrForbidden.Status:
+			return "forbidden"
+		case ErrInternalError.Status:
+			return "internal_error"
+		}
+		return "server_error"
+	case net.Error:
+		return "network"
+	}
+
+	switch errors.Cause(err) {
+	case ErrNoResults:
+		return "no_results"
+	case errInvalidJSON:
+		return "invalid_json"
+	}
+
+	return "other"
+}
+
+// queryHash returns a short hash identifying the provided query.
+func queryHash(qry string) string {
+	sum := sha256.Sum256([]byte(qry))
+	return hex.EncodeToString(sum[:8])
+}
+
+// resultCount returns the number of entities decoded into the provided
+// result, which is expected to point to a slice.
+func resultCount(result interface{}, err error) int {
+	if err != nil || result == nil {
+		return 0
+	}
+
+	v := reflect.ValueOf(result)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice {
+		return v.Len()
+	}
+
+	return 1
+}
+
+// retries returns the number of times the Call's request was resent.
+func retries(call *Call) int {
+	if call.Attempts < 2 {
+		return 0
+	}
+
+	return call.Attempts - 1
+}
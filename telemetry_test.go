@@ -0,0 +1,162 @@
+package igdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// testSpan records the attributes and errors set on a span.
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	errs  []error
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.errs = append(s.errs, err) }
+
+func (s *testSpan) End() { s.ended = true }
+
+// testTracer records every span it starts.
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &testSpan{name: name, attrs: make(map[string]interface{})}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+// testMeter sums the values recorded by each of its instruments by name.
+type testMeter struct {
+	mu   sync.Mutex
+	sums map[string]float64
+}
+
+type testInstrument struct {
+	m    *testMeter
+	name string
+}
+
+func (i testInstrument) Add(v float64, attrs ...Attribute)    { i.record(v, attrs) }
+func (i testInstrument) Record(v float64, attrs ...Attribute) { i.record(v, attrs) }
+
+func (i testInstrument) record(v float64, attrs []Attribute) {
+	i.m.mu.Lock()
+	defer i.m.mu.Unlock()
+
+	key := i.name
+	for _, a := range attrs {
+		if a.Key == AttrErrorType {
+			key += "/" + fmt.Sprint(a.Value)
+		}
+	}
+	i.m.sums[key] += v
+}
+
+func (m *testMeter) Counter(name string) Counter     { return testInstrument{m, name} }
+func (m *testMeter) Histogram(name string) Histogram { return testInstrument{m, name} }
+
+func TestInstrument(t *testing.T) {
+	ts, _, _ := testCountingServer(func(n int32, w http.ResponseWriter, r *http.Request) {
+		if n == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if n == 3 {
+			fmt.Fprint(w, "[]")
+			return
+		}
+		fmt.Fprint(w, `[{"some_field": "a"}, {"some_field": "b"}]`)
+	})
+	defer ts.Close()
+
+	tr := &testTracer{}
+	m := &testMeter{sums: make(map[string]float64)}
+
+	c := NewClient(testKey, ts.Client(), WithTracer(tr), WithMeter(m), WithMiddleware(Retry(1, time.Millisecond)))
+	c.rootURL = ts.URL + "/"
+
+	var res []testResultPlaceholder
+	if err := c.get(testEndpoint, &res, SetLimit(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.get(testEndpoint, &res); errors.Cause(err) != ErrNoResults {
+		t.Fatalf("got: <%v>, want: <%v>", errors.Cause(err), ErrNoResults)
+	}
+
+	if len(tr.spans) != 2 {
+		t.Fatalf("got: <%v> spans, want: <%v>", len(tr.spans), 2)
+	}
+
+	s := tr.spans[0]
+	want := map[string]interface{}{
+		AttrEndpoint:    string(testEndpoint),
+		AttrQueryHash:   queryHash("limit 2; "),
+		AttrResultCount: 2,
+		AttrStatusCode:  http.StatusOK,
+		AttrRetryCount:  1,
+	}
+	for k, v := range want {
+		if s.attrs[k] != v {
+			t.Errorf("%s got: <%v>, want: <%v>", k, s.attrs[k], v)
+		}
+	}
+	if s.name != "igdb test" || !s.ended || len(s.errs) != 0 {
+		t.Errorf("got: <%+v>, want an ended span without errors", s)
+	}
+
+	s = tr.spans[1]
+	if s.attrs[AttrErrorType] != "no_results" || len(s.errs) != 1 {
+		t.Errorf("got: <%+v>, want a no_results error", s)
+	}
+
+	sums := map[string]float64{
+		MetricRequests:               2,
+		MetricQuota:                  3,
+		MetricErrors + "/no_results": 1,
+	}
+	for k, v := range sums {
+		if m.sums[k] != v {
+			t.Errorf("%s got: <%v>, want: <%v>", k, m.sums[k], v)
+		}
+	}
+	if m.sums[MetricBytes] == 0 || m.sums[MetricDuration] == 0 {
+		t.Errorf("got: <%v>, want bytes and duration recorded", m.sums)
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"Nil", nil, ""},
+		{"No results", errors.Wrap(ErrNoResults, "wrapped"), "no_results"},
+		{"Invalid JSON", errors.Wrap(errInvalidJSON, "wrapped"), "invalid_json"},
+		{"Unauthorized", ErrUnauthorized, "unauthorized"},
+		{"Other server error", ServerError{Status: http.StatusNotFound}, "server_error"},
+		{"Other", ErrNegativeID, "other"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ErrorType(test.err); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
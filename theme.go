@@ -37,6 +37,19 @@ func (ts *ThemeService) Get(id int, opts ...Option) (*Theme, error) {
 	return th[0], nil
 }
 
+// ThemeBySlug returns a single Theme identified by the provided slug using the
+// provided ThemeReader, such as the Themes field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Themes, an error is returned.
+func ThemeBySlug(r ThemeReader, slug string, opts ...Option) (*Theme, error) {
+	th, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Theme with slug %s", slug)
+	}
+
+	return th[0], nil
+}
+
 // List returns a list of Themes identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Theme is ignored. If none of the IDs
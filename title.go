@@ -40,6 +40,19 @@ func (ts *TitleService) Get(id int, opts ...Option) (*Title, error) {
 	return t[0], nil
 }
 
+// TitleBySlug returns a single Title identified by the provided slug using the
+// provided TitleReader, such as the Titles field of a Client. Provide the
+// SetFields functional option if you need to specify which fields to retrieve.
+// If the slug does not match any Titles, an error is returned.
+func TitleBySlug(r TitleReader, slug string, opts ...Option) (*Title, error) {
+	t, err := r.Index(append(opts, setSlug(slug))...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Title with slug %s", slug)
+	}
+
+	return t[0], nil
+}
+
 // List returns a list of Titles identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Title is ignored. If none of the IDs
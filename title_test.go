@@ -57,6 +57,49 @@ func TestTitleService_Get(t *testing.T) {
 	}
 }
 
+func TestTitleBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testTitleGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Title, 1)
+	json.Unmarshal(f, &init)
+
+	var tests = []struct {
+		name      string
+		file      string
+		slug      string
+		opts      []Option
+		wantTitle *Title
+		wantErr   error
+	}{
+		{"Valid response", testTitleGet, "slug", []Option{SetFields("name")}, init[0], nil},
+		{"Blank slug", testFileEmpty, " ", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "slug", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "slug", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			title, err := TitleBySlug(c.Titles, test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(title, test.wantTitle) {
+				t.Errorf("got: <%v>, \nwant: <%v>", title, test.wantTitle)
+			}
+		})
+	}
+}
+
 func TestTitleService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testTitleList)
 	if err != nil {
@@ -0,0 +1,76 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// UnixTime is a point in time represented by the IGDB as the number of
+// seconds elapsed since January 1, 1970 UTC. A UnixTime of 0, which is also
+// the result of decoding a missing or null timestamp, represents an unknown
+// time and is converted to the zero time.Time.
+type UnixTime int64
+
+// NewUnixTime returns the UnixTime for the provided time. The zero time.Time
+// is returned as a UnixTime of 0.
+func NewUnixTime(t time.Time) UnixTime {
+	if t.IsZero() {
+		return 0
+	}
+
+	return UnixTime(t.Unix())
+}
+
+// Time returns the UnixTime as a UTC time.Time. The zero time.Time is
+// returned for a UnixTime of 0.
+func (u UnixTime) Time() time.Time {
+	if u == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(u), 0).UTC()
+}
+
+// IsZero returns true if the UnixTime represents an unknown time.
+func (u UnixTime) IsZero() bool {
+	return u == 0
+}
+
+// String returns the UnixTime formatted as RFC 3339, or an empty string for
+// an unknown time.
+func (u UnixTime) String() string {
+	if u == 0 {
+		return ""
+	}
+
+	return u.Time().Format(time.RFC3339)
+}
+
+// MarshalJSON fulfills the json.Marshaler interface. The UnixTime is encoded
+// as seconds, the same way it is received from the IGDB.
+func (u UnixTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(u), 10)), nil
+}
+
+// UnmarshalJSON fulfills the json.Unmarshaler interface. A null timestamp
+// is decoded as 0 and fractional seconds are truncated.
+func (u *UnixTime) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if string(b) == "null" {
+		*u = 0
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(b, &f); err != nil {
+		return errors.Wrapf(err, "cannot decode Unix time %s", b)
+	}
+
+	*u = UnixTime(math.Trunc(f))
+	return nil
+}
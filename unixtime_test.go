@@ -0,0 +1,87 @@
+package igdb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnixTime_Time(t *testing.T) {
+	tests := []struct {
+		name string
+		u    UnixTime
+		want time.Time
+	}{
+		{"Zero", 0, time.Time{}},
+		{"Epoch second", 1, time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC)},
+		{"Release date", 1538092800, time.Date(2018, 9, 28, 0, 0, 0, 0, time.UTC)},
+		{"Before epoch", -86400, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.u.Time()
+			if !got.Equal(test.want) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+
+			if u := NewUnixTime(got); u != test.u {
+				t.Errorf("got: <%v>, want: <%v>", u, test.u)
+			}
+		})
+	}
+}
+
+func TestUnixTime_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    UnixTime
+		wantErr bool
+	}{
+		{"Seconds", `{"date": 1538092800}`, 1538092800, false},
+		{"Fractional seconds", `{"date": 1538092800.75}`, 1538092800, false},
+		{"Null", `{"date": null}`, 0, false},
+		{"Missing", `{}`, 0, false},
+		{"String", `{"date": "yesterday"}`, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var rd ReleaseDate
+			err := json.Unmarshal([]byte(test.data), &rd)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got: <%v>, want error: <%v>", err, test.wantErr)
+			}
+
+			if rd.Date != test.want {
+				t.Errorf("got: <%v>, want: <%v>", rd.Date, test.want)
+			}
+		})
+	}
+}
+
+func TestUnixTime_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(ReleaseDate{Date: 1538092800})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got["date"] != float64(1538092800) || got["created_at"] != float64(0) {
+		t.Errorf("got: <%v>, want seconds", got)
+	}
+}
+
+func TestUnixTime_String(t *testing.T) {
+	if got := UnixTime(0).String(); got != "" {
+		t.Errorf("got: <%v>, want: <%v>", got, "")
+	}
+
+	want := "2018-09-28T00:00:00Z"
+	if got := UnixTime(1538092800).String(); got != want {
+		t.Errorf("got: <%v>, want: <%v>", got, want)
+	}
+}
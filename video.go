@@ -0,0 +1,259 @@
+package igdb
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// ErrVideoURL occurs when a URL does not address a YouTube or Twitch video.
+var ErrVideoURL = errors.New("unrecognized video url")
+
+// Base URLs of the video hosts.
+const (
+	youtubeWatchURL    = "https://www.youtube.com/watch?v="
+	youtubeEmbedURL    = "https://www.youtube.com/embed/"
+	youtubeNoCookieURL = "https://www.youtube-nocookie.com/embed/"
+	youtubeThumbURL    = "https://i.ytimg.com/vi/"
+	twitchVideoURL     = "https://www.twitch.tv/videos/"
+	twitchClipURL      = "https://clips.twitch.tv/"
+)
+
+// youtubeID matches a YouTube video ID.
+var youtubeID = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// thumbnailQuality is the quality of a YouTube video thumbnail.
+type thumbnailQuality string
+
+// Available thumbnail qualities supported by YouTube
+const (
+	// ThumbnailDefault is sized at 120x90.
+	ThumbnailDefault thumbnailQuality = "default"
+	// ThumbnailMedium is sized at 320x180.
+	ThumbnailMedium thumbnailQuality = "mqdefault"
+	// ThumbnailHigh is sized at 480x360.
+	ThumbnailHigh thumbnailQuality = "hqdefault"
+	// ThumbnailStandard is sized at 640x480.
+	ThumbnailStandard thumbnailQuality = "sddefault"
+	// ThumbnailMax is sized at 1280x720. It is only available for videos
+	// uploaded at that resolution or higher.
+	ThumbnailMax thumbnailQuality = "maxresdefault"
+)
+
+// EmbedOptions configure the player of an embedded YouTube video.
+type EmbedOptions struct {
+	// Autoplay starts the video when the player loads. Most browsers only
+	// allow this when the video is also muted.
+	Autoplay bool
+	// Mute starts the video without sound.
+	Mute bool
+	// Loop restarts the video when it ends.
+	Loop bool
+	// HideControls hides the player controls.
+	HideControls bool
+	// Start is the number of seconds into the video to start playing at.
+	Start int
+	// NoCookie embeds the video from the privacy-enhanced YouTube domain,
+	// which does not store cookies until the video is played.
+	NoCookie bool
+}
+
+// WatchURL returns the URL of the YouTube page of the video. An empty
+// string is returned if the video has no video ID.
+func (v GameVideo) WatchURL() string {
+	if blank.Is(v.VideoID) {
+		return ""
+	}
+
+	return youtubeWatchURL + url.QueryEscape(v.VideoID)
+}
+
+// EmbedURL returns the URL of the YouTube player of the video configured by
+// the provided options, such as for the src attribute of an HTML iframe
+// element. An empty string is returned if the video has no video ID.
+func (v GameVideo) EmbedURL(opts EmbedOptions) string {
+	if blank.Is(v.VideoID) {
+		return ""
+	}
+
+	base := youtubeEmbedURL
+	if opts.NoCookie {
+		base = youtubeNoCookieURL
+	}
+
+	q := url.Values{}
+	if opts.Autoplay {
+		q.Set("autoplay", "1")
+	}
+	if opts.Mute {
+		q.Set("mute", "1")
+	}
+	if opts.Loop {
+		// A single video only loops when it is also its own playlist.
+		q.Set("loop", "1")
+		q.Set("playlist", v.VideoID)
+	}
+	if opts.HideControls {
+		q.Set("controls", "0")
+	}
+	if opts.Start > 0 {
+		q.Set("start", strconv.Itoa(opts.Start))
+	}
+
+	u := base + url.PathEscape(v.VideoID)
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	return u
+}
+
+// ThumbnailURL returns the URL of the YouTube thumbnail of the video at the
+// provided quality. An empty string is returned if the video has no video
+// ID.
+func (v GameVideo) ThumbnailURL(quality thumbnailQuality) string {
+	if blank.Is(v.VideoID) {
+		return ""
+	}
+
+	return youtubeThumbURL + url.PathEscape(v.VideoID) + "/" + string(quality) + ".jpg"
+}
+
+// videoProvider is the host of a video.
+type videoProvider string
+
+// Available video providers recognized by ParseVideoURL
+const (
+	// ProviderYouTube is a YouTube video.
+	ProviderYouTube videoProvider = "youtube"
+	// ProviderTwitch is a Twitch video, such as a past broadcast.
+	ProviderTwitch videoProvider = "twitch"
+	// ProviderTwitchClip is a Twitch clip.
+	ProviderTwitchClip videoProvider = "twitch_clip"
+)
+
+// VideoRef identifies a video hosted by YouTube or Twitch.
+type VideoRef struct {
+	Provider videoProvider
+	ID       string
+}
+
+// WatchURL returns the URL of the page of the video on its provider.
+func (r VideoRef) WatchURL() string {
+	switch r.Provider {
+	case ProviderYouTube:
+		return youtubeWatchURL + url.QueryEscape(r.ID)
+	case ProviderTwitch:
+		return twitchVideoURL + url.PathEscape(r.ID)
+	case ProviderTwitchClip:
+		return twitchClipURL + url.PathEscape(r.ID)
+	}
+
+	return ""
+}
+
+// GameVideo returns the video as a GameVideo if it is hosted by YouTube.
+func (r VideoRef) GameVideo() (*GameVideo, bool) {
+	if r.Provider != ProviderYouTube {
+		return nil, false
+	}
+
+	return &GameVideo{VideoID: r.ID}, true
+}
+
+// ParseVideoURL parses the provided URL of a YouTube or Twitch video, such
+// as one of the Videos of a Pulse. Watch, share, embed, and shorts URLs are
+// recognized for YouTube, and video, clip, and player URLs for Twitch.
+func ParseVideoURL(rawURL string) (*VideoRef, error) {
+	s := strings.TrimSpace(rawURL)
+	if strings.HasPrefix(s, "//") {
+		s = "https:" + s
+	} else if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, errors.Wrap(ErrVideoURL, err.Error())
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	parts := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+
+	var ref *VideoRef
+	switch host {
+	case "youtube.com", "youtube-nocookie.com":
+		id := u.Query().Get("v")
+		if len(parts) == 2 {
+			switch parts[0] {
+			case "embed", "v", "shorts", "live":
+				id = parts[1]
+			}
+		}
+		ref = &VideoRef{Provider: ProviderYouTube, ID: id}
+	case "youtu.be":
+		if len(parts) == 1 {
+			ref = &VideoRef{Provider: ProviderYouTube, ID: parts[0]}
+		}
+	case "twitch.tv":
+		switch {
+		case len(parts) == 2 && parts[0] == "videos":
+			ref = &VideoRef{Provider: ProviderTwitch, ID: parts[1]}
+		case len(parts) == 3 && parts[1] == "clip":
+			ref = &VideoRef{Provider: ProviderTwitchClip, ID: parts[2]}
+		}
+	case "player.twitch.tv":
+		if v := u.Query().Get("video"); v != "" {
+			ref = &VideoRef{Provider: ProviderTwitch, ID: strings.TrimPrefix(v, "v")}
+		} else if c := u.Query().Get("clip"); c != "" {
+			ref = &VideoRef{Provider: ProviderTwitchClip, ID: c}
+		}
+	case "clips.twitch.tv":
+		switch {
+		case len(parts) == 1 && parts[0] == "embed":
+			ref = &VideoRef{Provider: ProviderTwitchClip, ID: u.Query().Get("clip")}
+		case len(parts) == 1:
+			ref = &VideoRef{Provider: ProviderTwitchClip, ID: parts[0]}
+		}
+	}
+
+	if ref == nil || !ref.valid() {
+		return nil, errors.Wrapf(ErrVideoURL, "cannot parse '%s'", rawURL)
+	}
+
+	return ref, nil
+}
+
+// valid returns true if the ID of the video is well formed for its
+// provider.
+func (r VideoRef) valid() bool {
+	switch r.Provider {
+	case ProviderYouTube:
+		return youtubeID.MatchString(r.ID)
+	case ProviderTwitch:
+		_, err := strconv.ParseUint(r.ID, 10, 64)
+		return err == nil
+	case ProviderTwitchClip:
+		return !blank.Is(r.ID)
+	}
+
+	return false
+}
+
+// VideoRefs returns the videos of the Pulse that are hosted by YouTube or
+// Twitch. Videos with unrecognized URLs are left out.
+func (p Pulse) VideoRefs() []VideoRef {
+	var refs []VideoRef
+	for _, v := range p.Videos {
+		if ref, err := ParseVideoURL(v); err == nil {
+			refs = append(refs, *ref)
+		}
+	}
+
+	return refs
+}
@@ -0,0 +1,164 @@
+package igdb
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testVideoID = "T1TOkHkFaoc"
+
+func TestGameVideo_WatchURL(t *testing.T) {
+	var tests = []struct {
+		name string
+		vid  GameVideo
+		want string
+	}{
+		{"Video ID", GameVideo{VideoID: testVideoID}, "https://www.youtube.com/watch?v=T1TOkHkFaoc"},
+		{"No video ID", GameVideo{}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.vid.WatchURL(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGameVideo_EmbedURL(t *testing.T) {
+	var tests = []struct {
+		name string
+		vid  GameVideo
+		opts EmbedOptions
+		want string
+	}{
+		{"Default", GameVideo{VideoID: testVideoID}, EmbedOptions{}, "https://www.youtube.com/embed/T1TOkHkFaoc"},
+		{"Muted autoplay", GameVideo{VideoID: testVideoID}, EmbedOptions{Autoplay: true, Mute: true}, "https://www.youtube.com/embed/T1TOkHkFaoc?autoplay=1&mute=1"},
+		{"Loop", GameVideo{VideoID: testVideoID}, EmbedOptions{Loop: true}, "https://www.youtube.com/embed/T1TOkHkFaoc?loop=1&playlist=T1TOkHkFaoc"},
+		{"No cookie with start", GameVideo{VideoID: testVideoID}, EmbedOptions{NoCookie: true, HideControls: true, Start: 90}, "https://www.youtube-nocookie.com/embed/T1TOkHkFaoc?controls=0&start=90"},
+		{"No video ID", GameVideo{}, EmbedOptions{Autoplay: true}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.vid.EmbedURL(test.opts); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGameVideo_ThumbnailURL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		vid     GameVideo
+		quality thumbnailQuality
+		want    string
+	}{
+		{"High", GameVideo{VideoID: testVideoID}, ThumbnailHigh, "https://i.ytimg.com/vi/T1TOkHkFaoc/hqdefault.jpg"},
+		{"Max", GameVideo{VideoID: testVideoID}, ThumbnailMax, "https://i.ytimg.com/vi/T1TOkHkFaoc/maxresdefault.jpg"},
+		{"No video ID", GameVideo{}, ThumbnailDefault, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.vid.ThumbnailURL(test.quality); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseVideoURL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		url     string
+		want    VideoRef
+		wantErr error
+	}{
+		{"YouTube watch", "https://www.youtube.com/watch?v=T1TOkHkFaoc&t=10s", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube mobile", "https://m.youtube.com/watch?v=T1TOkHkFaoc", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube share", "https://youtu.be/T1TOkHkFaoc", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube embed", "//www.youtube.com/embed/T1TOkHkFaoc?autoplay=1", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube no cookie", "https://www.youtube-nocookie.com/embed/T1TOkHkFaoc", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube shorts", "youtube.com/shorts/T1TOkHkFaoc", VideoRef{ProviderYouTube, testVideoID}, nil},
+		{"YouTube invalid ID", "https://www.youtube.com/watch?v=short", VideoRef{}, ErrVideoURL},
+		{"YouTube channel", "https://www.youtube.com/user/igdb", VideoRef{}, ErrVideoURL},
+		{"Twitch video", "https://www.twitch.tv/videos/123456789", VideoRef{ProviderTwitch, "123456789"}, nil},
+		{"Twitch player", "https://player.twitch.tv/?video=v123456789&parent=example.com", VideoRef{ProviderTwitch, "123456789"}, nil},
+		{"Twitch clip", "https://clips.twitch.tv/FunnyClipSlug", VideoRef{ProviderTwitchClip, "FunnyClipSlug"}, nil},
+		{"Twitch channel clip", "https://www.twitch.tv/igdb/clip/FunnyClipSlug", VideoRef{ProviderTwitchClip, "FunnyClipSlug"}, nil},
+		{"Twitch clip embed", "https://clips.twitch.tv/embed?clip=FunnyClipSlug", VideoRef{ProviderTwitchClip, "FunnyClipSlug"}, nil},
+		{"Twitch invalid video", "https://www.twitch.tv/videos/abc", VideoRef{}, ErrVideoURL},
+		{"Twitch channel", "https://www.twitch.tv/igdb", VideoRef{}, ErrVideoURL},
+		{"Other host", "https://vimeo.com/123456", VideoRef{}, ErrVideoURL},
+		{"Empty", "", VideoRef{}, ErrVideoURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ref, err := ParseVideoURL(test.url)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if *ref != test.want {
+				t.Errorf("got: <%v>, want: <%v>", *ref, test.want)
+			}
+		})
+	}
+}
+
+func TestVideoRef_WatchURL(t *testing.T) {
+	var tests = []struct {
+		name string
+		ref  VideoRef
+		want string
+	}{
+		{"YouTube", VideoRef{ProviderYouTube, testVideoID}, "https://www.youtube.com/watch?v=T1TOkHkFaoc"},
+		{"Twitch", VideoRef{ProviderTwitch, "123"}, "https://www.twitch.tv/videos/123"},
+		{"Twitch clip", VideoRef{ProviderTwitchClip, "Slug"}, "https://clips.twitch.tv/Slug"},
+		{"Unknown provider", VideoRef{}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.ref.WatchURL(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+
+	vid, ok := VideoRef{ProviderYouTube, testVideoID}.GameVideo()
+	if !ok || vid.VideoID != testVideoID {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", vid, ok, testVideoID, true)
+	}
+	if _, ok := (VideoRef{ProviderTwitch, "123"}).GameVideo(); ok {
+		t.Errorf("got: <%v>, want: <%v>", ok, false)
+	}
+}
+
+func TestPulse_VideoRefs(t *testing.T) {
+	p := Pulse{Videos: []string{
+		"https://youtu.be/T1TOkHkFaoc",
+		"https://example.com/video.mp4",
+		"https://www.twitch.tv/videos/123",
+	}}
+
+	want := []VideoRef{{ProviderYouTube, testVideoID}, {ProviderTwitch, "123"}}
+
+	got := p.VideoRefs()
+	if len(got) != len(want) {
+		t.Fatalf("got: <%v>, want: <%v>", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got: <%v>, want: <%v>", got[i], want[i])
+		}
+	}
+}
@@ -14,13 +14,29 @@ type Website struct {
 	ID       int             `json:"id"`
 	Category WebsiteCategory `json:"category"`
 	Trusted  bool            `json:"trusted"`
+	Type     WebsiteType     `json:"type"`
 	URL      string          `json:"url"`
 }
 
+// Kind returns the WebsiteType of the Website. The Type is returned if it
+// was received; otherwise the Category is converted to its WebsiteType. Zero
+// is returned if neither identifies a current website.
+func (w *Website) Kind() WebsiteType {
+	if w.Type != 0 {
+		return w.Type
+	}
+
+	return w.Category.Type()
+}
+
+//go:generate stringer -type=WebsiteCategory
+
 // WebsiteCategory specifies a specific popular website.
 type WebsiteCategory int
 
-// Expected WebsiteCategory enums from the IGDB.
+// Expected WebsiteCategory enums from version 3 of the IGDB API, which this
+// client targets. Later versions renumber the websites after WebsiteReddit
+// and add newer ones; see WebsiteType.
 const (
 	WebsiteOfficial WebsiteCategory = iota + 1
 	WebsiteWikia
@@ -44,6 +60,52 @@ const (
 	WebsiteSoundcloud
 )
 
+// Type returns the WebsiteType of the same website, or zero if the website
+// is no longer listed by later versions of the IGDB API (e.g.
+// WebsiteGooglePlus).
+func (w WebsiteCategory) Type() WebsiteType {
+	switch {
+	case w >= WebsiteOfficial && w <= WebsiteReddit:
+		return WebsiteType(w)
+	case w == WebsiteDiscord:
+		return WebsiteTypeDiscord
+	}
+
+	return 0
+}
+
+//go:generate stringer -type=WebsiteType
+
+// WebsiteType specifies a specific popular website using the numbering of
+// later versions of the IGDB API, which received websites carry in their
+// Type rather than their Category.
+type WebsiteType int
+
+// Expected WebsiteType enums from later versions of the IGDB API. The values
+// up to WebsiteTypeReddit match their WebsiteCategory; the values after it do
+// not.
+const (
+	WebsiteTypeOfficial WebsiteType = iota + 1
+	WebsiteTypeCommunityWiki
+	WebsiteTypeWikipedia
+	WebsiteTypeFacebook
+	WebsiteTypeTwitter
+	WebsiteTypeTwitch
+	_
+	WebsiteTypeInstagram
+	WebsiteTypeYoutube
+	WebsiteTypeIphone
+	WebsiteTypeIpad
+	WebsiteTypeAndroid
+	WebsiteTypeSteam
+	WebsiteTypeReddit
+	WebsiteTypeItch
+	WebsiteTypeEpicGames
+	WebsiteTypeGOG
+	WebsiteTypeDiscord
+	WebsiteTypeBluesky
+)
+
 // WebsiteService handles all the API calls for the IGDB Website endpoint.
 type WebsiteService service
 
@@ -203,3 +203,38 @@ func TestWebsiteService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestWebsite_Kind(t *testing.T) {
+	var tests = []struct {
+		name string
+		web  Website
+		want WebsiteType
+	}{
+		{"Type received", Website{Category: WebsiteDiscord, Type: WebsiteTypeBluesky}, WebsiteTypeBluesky},
+		{"Shared numbering", Website{Category: WebsiteSteam}, WebsiteTypeSteam},
+		{"Renumbered website", Website{Category: WebsiteDiscord}, WebsiteTypeDiscord},
+		{"Unlisted website", Website{Category: WebsiteGooglePlus}, 0},
+		{"Neither", Website{}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.web.Kind(); got != test.want {
+				t.Errorf("got: <%v>, want: <%v>", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWebsite_UnmarshalType(t *testing.T) {
+	var w Website
+	if err := json.Unmarshal([]byte(`{"id": 1, "type": 16, "url": "https://store.epicgames.com"}`), &w); err != nil {
+		t.Fatal(err)
+	}
+
+	if w.Type != WebsiteTypeEpicGames || w.Kind() != WebsiteTypeEpicGames {
+		t.Errorf("got: <%v, %v>, want: <%v>", w.Type, w.Kind(), WebsiteTypeEpicGames)
+	}
+	if w.Type.Name() != "epic_games" {
+		t.Errorf("got: <%v>, want: <%v>", w.Type.Name(), "epic_games")
+	}
+}